@@ -51,6 +51,9 @@ type ArgoCDProviderConfig struct {
 	Insecure        types.Bool   `tfsdk:"insecure"`
 	PlainText       types.Bool   `tfsdk:"plain_text"`
 	UserAgent       types.String `tfsdk:"user_agent"`
+
+	// Cluster sharding
+	ShardCount types.Int64 `tfsdk:"shard_count"`
 }
 
 func (p ArgoCDProviderConfig) getApiClientOptions(ctx context.Context) (*apiclient.ClientOptions, diag.Diagnostics) {
@@ -8,6 +8,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	// Import to initialize client auth plugins.
 
@@ -78,6 +79,12 @@ func Provider() *schema.Provider {
 				Optional:    true,
 				Description: "User-Agent request header override.",
 			},
+			"shard_count": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Description:  "Number of application controller shards. When set, clusters managed by `argocd_cluster` without an explicit `shard` are assigned to a shard deterministically computed from their server address.",
+				ValidateFunc: validation.IntAtLeast(1),
+			},
 			"core": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -270,6 +277,7 @@ func argoCDProviderConfigFromResourceData(ctx context.Context, d *schema.Resourc
 		PortForward:              getBoolFromResourceData(d, "port_forward"),
 		PortForwardWithNamespace: getStringFromResourceData(d, "port_forward_with_namespace"),
 		ServerAddr:               getStringFromResourceData(d, "server_addr"),
+		ShardCount:               getInt64FromResourceData(d, "shard_count"),
 		UseLocalConfig:           getBoolFromResourceData(d, "use_local_config"),
 		UserAgent:                getStringFromResourceData(d, "user_agent"),
 		Username:                 getStringFromResourceData(d, "username"),
@@ -349,6 +357,14 @@ func getBoolFromResourceData(d *schema.ResourceData, key string) types.Bool {
 	return types.BoolNull()
 }
 
+func getInt64FromResourceData(d *schema.ResourceData, key string) types.Int64 {
+	if v, ok := d.GetOk(key); ok {
+		return types.Int64Value(int64(v.(int)))
+	}
+
+	return types.Int64Null()
+}
+
 func getStringListFromResourceData(ctx context.Context, d *schema.ResourceData, key string) (types.List, fwdiag.Diagnostics) {
 	if v, ok := d.GetOk(key); ok {
 		return types.ListValueFrom(ctx, types.StringType, v.([]interface{}))
@@ -8,6 +8,7 @@ import (
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
+	"github.com/argoproj-labs/terraform-provider-argocd/internal/diagnostics"
 	"github.com/argoproj-labs/terraform-provider-argocd/internal/features"
 	"github.com/argoproj/argo-cd/gitops-engine/pkg/health"
 	applicationClient "github.com/argoproj/argo-cd/v3/pkg/apiclient/application"
@@ -53,6 +54,11 @@ func resourceArgoCDApplication() *schema.Resource {
 				Optional:    true,
 				Default:     true,
 			},
+			"adopt_existing": {
+				Type:        schema.TypeBool,
+				Description: "Whether to take over a pre-existing application with the same name and namespace instead of failing on creation when its configuration differs. Applications generated by an `argocd_application_set` are never adopted.",
+				Optional:    true,
+			},
 			"status": applicationStatusSchema(),
 		},
 		SchemaVersion: 4,
@@ -105,6 +111,8 @@ func resourceArgoCDApplicationCreate(ctx context.Context, d *schema.ResourceData
 		return errorToDiagnostics(fmt.Sprintf("failed to list existing applications when creating application %s", objectMeta.Name), err)
 	}
 
+	adopted := false
+
 	if apps != nil {
 		l := len(apps.Items)
 
@@ -114,6 +122,19 @@ func resourceArgoCDApplicationCreate(ctx context.Context, d *schema.ResourceData
 		case l == 1:
 			switch apps.Items[0].DeletionTimestamp {
 			case nil:
+				if o := applicationSetOwnerReference(apps.Items[0].ObjectMeta); o != nil {
+					return []diag.Diagnostic{
+						{
+							Severity: diag.Error,
+							Summary:  fmt.Sprintf("application %s in namespace %s is managed by application set %s", objectMeta.Name, objectMeta.Namespace, o.Name),
+							Detail:   "Managing an application generated by an application set would cause both controllers to continuously overwrite each other. Rename the application or remove it from the application set generators.",
+						},
+					}
+				}
+
+				// Without adoption, ArgoCD only accepts the creation of an
+				// application identical to the existing one
+				adopted = d.Get("adopt_existing").(bool)
 			default:
 				// Pre-existing app is still in Kubernetes soft deletion queue
 				time.Sleep(time.Duration(*apps.Items[0].DeletionGracePeriodSeconds))
@@ -153,10 +174,11 @@ func resourceArgoCDApplicationCreate(ctx context.Context, d *schema.ResourceData
 			},
 		},
 		Validate: &validate,
+		Upsert:   &adopted,
 	})
 
 	if err != nil {
-		return argoCDAPIError("create", "application", objectMeta.Name, err)
+		return pluginSDKDiags(diagnostics.CreateError("application", objectMeta.Name, err))
 	} else if app == nil {
 		return []diag.Diagnostic{
 			{
@@ -224,7 +246,12 @@ func resourceArgoCDApplicationCreate(ctx context.Context, d *schema.ResourceData
 		}
 	}
 
-	return resourceArgoCDApplicationRead(ctx, d, meta)
+	diags := resourceArgoCDApplicationRead(ctx, d, meta)
+	if adopted {
+		diags = append(diags, pluginSDKDiags(diagnostics.Adopted("application", objectMeta.Name))...)
+	}
+
+	return diags
 }
 
 func resourceArgoCDApplicationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
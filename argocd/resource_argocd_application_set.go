@@ -2,30 +2,46 @@ package argocd
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"maps"
+	"slices"
+	"sort"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/argoproj-labs/terraform-provider-argocd/internal/features"
+	"github.com/argoproj/argo-cd/gitops-engine/pkg/health"
+	applicationClient "github.com/argoproj/argo-cd/v3/pkg/apiclient/application"
 	"github.com/argoproj/argo-cd/v3/pkg/apiclient/applicationset"
 	application "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 func resourceArgoCDApplicationSet() *schema.Resource {
 	return &schema.Resource{
-		Description:   "Manages [application sets](https://argo-cd.readthedocs.io/en/stable/user-guide/application-set/) within ArgoCD.",
+		Description:   "Manages [application sets](https://argo-cd.readthedocs.io/en/stable/user-guide/application-set/) within ArgoCD. Errors reported by the ApplicationSet controller, e.g. template rendering failures, are surfaced as warnings after create and update rather than failing the apply, as the controller reconciles asynchronously and the errors read back may predate the change. Set `wait` to fail the apply on these errors.",
 		CreateContext: resourceArgoCDApplicationSetCreate,
 		ReadContext:   resourceArgoCDApplicationSetRead,
 		UpdateContext: resourceArgoCDApplicationSetUpdate,
 		DeleteContext: resourceArgoCDApplicationSetDelete,
+		CustomizeDiff: resourceArgoCDApplicationSetCustomizeDiff,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"metadata": metadataSchema("applicationsets.argoproj.io"),
 			"spec":     applicationSetSpecSchemaV1(),
+			"wait": {
+				Type:        schema.TypeBool,
+				Description: "Upon application set creation or update, wait for all generated applications to be Synced and Healthy (or, when a progressive sync `strategy` is configured, for the rollout to complete), when set to true. Wait timeouts are controlled by Terraform Create and Update resource timeouts (both default to 5 minutes).",
+				Optional:    true,
+			},
 		},
 		SchemaVersion: 1,
 		StateUpgraders: []schema.StateUpgrader{
@@ -35,6 +51,10 @@ func resourceArgoCDApplicationSet() *schema.Resource {
 				Version: 0,
 			},
 		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+		},
 	}
 }
 
@@ -98,13 +118,27 @@ func resourceArgoCDApplicationSetCreate(ctx context.Context, d *schema.ResourceD
 
 	d.SetId(fmt.Sprintf("%s:%s", as.Name, objectMeta.Namespace))
 
-	return resourceArgoCDApplicationSetRead(ctx, d, meta)
+	if wait, ok := d.GetOk("wait"); ok && wait.(bool) {
+		if err = waitForApplicationSet(ctx, si, as.Name, objectMeta.Namespace, d.Timeout(schema.TimeoutCreate)); err != nil {
+			return errorToDiagnostics(fmt.Sprintf("error while waiting for application set %s to be created", as.Name), err)
+		}
+	}
+
+	return readAppliedApplicationSet(ctx, d, meta)
 }
 
 func resourceArgoCDApplicationSetRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	_, diags := readApplicationSet(ctx, d, meta)
+
+	return diags
+}
+
+// readApplicationSet reads the application set into the resource data and
+// returns it, or nil if it does not exist anymore.
+func readApplicationSet(ctx context.Context, d *schema.ResourceData, meta interface{}) (*application.ApplicationSet, diag.Diagnostics) {
 	si := meta.(*ServerInterface)
 	if diags := si.InitClients(ctx); diags != nil {
-		return pluginSDKDiags(diags)
+		return nil, pluginSDKDiags(diags)
 	}
 
 	ids := strings.Split(d.Id(), ":")
@@ -118,18 +152,30 @@ func resourceArgoCDApplicationSetRead(ctx context.Context, d *schema.ResourceDat
 	if err != nil {
 		if strings.Contains(err.Error(), "NotFound") {
 			d.SetId("")
-			return diag.Diagnostics{}
+			return nil, diag.Diagnostics{}
 		}
 
-		return argoCDAPIError("read", "application set", appSetName, err)
+		return nil, argoCDAPIError("read", "application set", appSetName, err)
 	}
 
 	err = flattenApplicationSet(appSet, d)
 	if err != nil {
-		return errorToDiagnostics(fmt.Sprintf("failed to flatten application set %s", appSetName), err)
+		return nil, errorToDiagnostics(fmt.Sprintf("failed to flatten application set %s", appSetName), err)
 	}
 
-	return nil
+	return appSet, nil
+}
+
+// readAppliedApplicationSet reads the application set after it has been
+// created or updated, surfacing the errors reported by the ApplicationSet
+// controller once rather than on every refresh.
+func readAppliedApplicationSet(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	appSet, diags := readApplicationSet(ctx, d, meta)
+	if appSet == nil {
+		return diags
+	}
+
+	return append(diags, applicationSetConditionDiagnostics(appSet)...)
 }
 
 func resourceArgoCDApplicationSetUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -185,7 +231,13 @@ func resourceArgoCDApplicationSetUpdate(ctx context.Context, d *schema.ResourceD
 		return argoCDAPIError("update", "application set", objectMeta.Name, err)
 	}
 
-	return resourceArgoCDApplicationSetRead(ctx, d, meta)
+	if wait, ok := d.GetOk("wait"); ok && wait.(bool) {
+		if err = waitForApplicationSet(ctx, si, objectMeta.Name, objectMeta.Namespace, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return errorToDiagnostics(fmt.Sprintf("error while waiting for application set %s to be updated", objectMeta.Name), err)
+		}
+	}
+
+	return readAppliedApplicationSet(ctx, d, meta)
 }
 
 func resourceArgoCDApplicationSetDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -209,3 +261,386 @@ func resourceArgoCDApplicationSetDelete(ctx context.Context, d *schema.ResourceD
 
 	return nil
 }
+
+// resourceArgoCDApplicationSetCustomizeDiff validates the Go templates used in
+// the application set `generator`, `template` and `template_patch` at plan
+// time, rather than leaving it to the ApplicationSet controller to report errors
+// after apply.
+func resourceArgoCDApplicationSetCustomizeDiff(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if !d.NewValueKnown("spec.0.go_template") || !d.NewValueKnown("spec.0.go_template_options") {
+		return nil
+	}
+
+	goTemplate := d.Get("spec.0.go_template").(bool)
+
+	var options []string
+	if v, ok := d.Get("spec.0.go_template_options").(*schema.Set); ok {
+		options = sliceOfString(v.List())
+	}
+
+	var errs []error
+
+	if goTemplate {
+		if err := validateGoTemplateOptions(options); err != nil {
+			return fmt.Errorf("spec.0.go_template_options: %w", err)
+		}
+
+		if t, ok := d.Get("spec.0.template").([]interface{}); ok && len(t) > 0 && t[0] != nil {
+			template, err := expandApplicationSetTemplate(t[0], true, true)
+			if err != nil {
+				return fmt.Errorf("failed to expand application set template: %w", err)
+			}
+
+			errs = append(errs, validateApplicationSetGoTemplates("template", template, options)...)
+		}
+
+		if g, ok := d.Get("spec.0.generator").([]interface{}); ok && len(g) > 0 && d.NewValueKnown("spec.0.generator") {
+			generators, err := expandApplicationSetGenerators(g, true, true)
+			if err != nil {
+				return fmt.Errorf("failed to expand application set generators: %w", err)
+			}
+
+			errs = append(errs, validateApplicationSetGeneratorGoTemplates("generator", generators, options)...)
+		}
+	}
+
+	if d.NewValueKnown("spec.0.template_patch") {
+		if tp, ok := d.GetOk("spec.0.template_patch"); ok {
+			if err := validateTemplatePatch(tp.(string), goTemplate, options); err != nil {
+				errs = append(errs, fmt.Errorf("spec.0.template_patch: %w", err))
+			}
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// validateApplicationSetGoTemplates parses every templated string within the
+// given part of the application set, e.g. its template, as a Go template.
+func validateApplicationSetGoTemplates(path string, o interface{}, options []string) []error {
+	v, err := applicationSetJSONValue(path, o)
+	if err != nil {
+		return []error{err}
+	}
+
+	return walkApplicationSetGoTemplates(path, v, options, nil)
+}
+
+// validateApplicationSetGeneratorGoTemplates parses the templated strings of
+// the application set generators that the ApplicationSet controller renders
+// for each parameter set: the generator template overrides and values and,
+// within a matrix generator, the generators after the first one, which are
+// interpolated with the parameters of the previous ones. List elements and
+// plugin input parameters are passed on verbatim to the generated
+// applications and commonly hold text meant for other templating engines,
+// e.g. Helm, so they are not validated.
+func validateApplicationSetGeneratorGoTemplates(path string, generators []application.ApplicationSetGenerator, options []string) []error {
+	v, err := applicationSetJSONValue(path, generators)
+	if err != nil {
+		return []error{err}
+	}
+
+	var errs []error
+
+	gs, _ := v.([]interface{})
+	for i, g := range gs {
+		errs = append(errs, validateGeneratorGoTemplates(fmt.Sprintf("%s[%d]", path, i), g, options)...)
+	}
+
+	return errs
+}
+
+func validateGeneratorGoTemplates(path string, g interface{}, options []string) (errs []error) {
+	m, _ := g.(map[string]interface{})
+
+	for _, kind := range slices.Sorted(maps.Keys(m)) {
+		generator, ok := m[kind].(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		p := path + "." + kind
+
+		for _, k := range []string{"template", "values"} {
+			if v, ok := generator[k]; ok {
+				errs = append(errs, walkApplicationSetGoTemplates(p+"."+k, v, options, nil)...)
+			}
+		}
+
+		children, _ := generator["generators"].([]interface{})
+		for i, c := range children {
+			cp := fmt.Sprintf("%s.generators[%d]", p, i)
+
+			if kind == "matrix" && i > 0 {
+				errs = append(errs, walkApplicationSetGoTemplates(cp, c, options, isVerbatimGeneratorField)...)
+				continue
+			}
+
+			errs = append(errs, validateGeneratorGoTemplates(cp, c, options)...)
+		}
+	}
+
+	return errs
+}
+
+// isVerbatimGeneratorField reports whether the generator field at the given
+// path is passed on verbatim to the generated applications.
+func isVerbatimGeneratorField(path string) bool {
+	for _, s := range []string{".list.elements", ".list.elementsYaml", ".plugin.input.parameters"} {
+		if strings.HasSuffix(path, s) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func applicationSetJSONValue(path string, o interface{}) (interface{}, error) {
+	b, err := json.Marshal(o)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal application set %s: %w", path, err)
+	}
+
+	var v interface{}
+	if err = json.Unmarshal(b, &v); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal application set %s: %w", path, err)
+	}
+
+	return v, nil
+}
+
+// walkApplicationSetGoTemplates parses every templated string within v as a Go
+// template, skipping the fields for which skip, if set, returns true.
+func walkApplicationSetGoTemplates(path string, v interface{}, options []string, skip func(path string) bool) (errs []error) {
+	if skip != nil && skip(path) {
+		return nil
+	}
+
+	switch t := v.(type) {
+	case map[string]interface{}:
+		for _, k := range slices.Sorted(maps.Keys(t)) {
+			errs = append(errs, walkApplicationSetGoTemplates(path+"."+k, t[k], options, skip)...)
+		}
+	case []interface{}:
+		for i, e := range t {
+			errs = append(errs, walkApplicationSetGoTemplates(fmt.Sprintf("%s[%d]", path, i), e, options, skip)...)
+		}
+	case string:
+		if !strings.Contains(t, "{{") {
+			return nil
+		}
+
+		if _, err := parseGoTemplate(t, options); err != nil {
+			errs = append(errs, fmt.Errorf("%s: invalid go template: %w", path, err))
+		}
+	}
+
+	return errs
+}
+
+// applicationSetConditionDiagnostics surfaces error conditions reported by the
+// ApplicationSet controller (e.g. template rendering failures) as warnings, as
+// they may have been reported for a previous version of the application set.
+func applicationSetConditionDiagnostics(appSet *application.ApplicationSet) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	for _, c := range appSet.Status.Conditions {
+		if c.Type != application.ApplicationSetConditionErrorOccurred || c.Status != application.ApplicationSetConditionStatusTrue {
+			continue
+		}
+
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  fmt.Sprintf("application set %s reported an error: %s", appSet.Name, c.Reason),
+			Detail:   c.Message,
+		})
+	}
+
+	return diags
+}
+
+// applicationSetWaitMinInterval is the initial interval between two polls of
+// the applications generated by an application set. It doubles after each
+// poll, up to 10 seconds.
+const applicationSetWaitMinInterval = 2 * time.Second
+
+// waitForApplicationSet polls the application set and the applications it
+// owns until they are all Synced and Healthy or, if the application set uses a
+// progressive sync strategy, until the rollout has completed. The polling
+// interval backs off, as listing the generated applications can be expensive
+// on large instances.
+func waitForApplicationSet(ctx context.Context, si *ServerInterface, name, namespace string, timeout time.Duration) error {
+	// The reason the application set is not ready is only known to Refresh,
+	// which runs in its own goroutine and may still be running once the wait
+	// has timed out, so it is guarded by a mutex.
+	var (
+		reason   error
+		reasonMu sync.Mutex
+	)
+
+	c := &retry.StateChangeConf{
+		Pending:    []string{"pending"},
+		Target:     []string{"ready"},
+		Timeout:    timeout,
+		Delay:      applicationSetWaitMinInterval,
+		MinTimeout: applicationSetWaitMinInterval,
+		Refresh: func() (interface{}, string, error) {
+			appSet, err := si.ApplicationSetClient.Get(ctx, &applicationset.ApplicationSetGetQuery{
+				Name:            name,
+				AppsetNamespace: namespace,
+			})
+			if err != nil {
+				return nil, "", fmt.Errorf("error while waiting for application set %s: %s", name, err)
+			}
+
+			apps, err := si.ApplicationClient.List(ctx, applicationSetApplicationQuery(appSet))
+			if err != nil {
+				return nil, "", fmt.Errorf("error while listing applications generated by application set %s: %s", name, err)
+			}
+
+			err = applicationSetReady(appSet, apps.Items)
+
+			reasonMu.Lock()
+			defer reasonMu.Unlock()
+
+			if reason = err; reason != nil {
+				return appSet, "pending", nil
+			}
+
+			return appSet, "ready", nil
+		},
+	}
+
+	if _, err := c.WaitForStateContext(ctx); err != nil {
+		var timeoutErr *retry.TimeoutError
+		if !errors.As(err, &timeoutErr) {
+			return err
+		}
+
+		reasonMu.Lock()
+		defer reasonMu.Unlock()
+
+		if reason != nil {
+			return fmt.Errorf("timed out while waiting for application set %s: %s", name, reason)
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+// applicationSetApplicationQuery returns a query scoped as narrowly as the
+// application set template allows to the applications it generates. Labels
+// and project are only used when they are not templated and cannot be
+// changed by a template patch; ownership is checked by applicationSetReady.
+func applicationSetApplicationQuery(appSet *application.ApplicationSet) *applicationClient.ApplicationQuery {
+	q := &applicationClient.ApplicationQuery{
+		AppNamespace: &appSet.Namespace,
+	}
+
+	if appSet.Spec.TemplatePatch != nil {
+		return q
+	}
+
+	if p := appSet.Spec.Template.Spec.Project; p != "" && !strings.Contains(p, "{{") {
+		q.Projects = []string{p}
+	}
+
+	var selector []string
+
+	for k, v := range appSet.Spec.Template.Labels {
+		if strings.Contains(k, "{{") || strings.Contains(v, "{{") {
+			continue
+		}
+
+		selector = append(selector, fmt.Sprintf("%s=%s", k, v))
+	}
+
+	if len(selector) > 0 {
+		sort.Strings(selector)
+
+		s := strings.Join(selector, ",")
+		q.Selector = &s
+	}
+
+	return q
+}
+
+// applicationSetReady returns an error describing why the application set is
+// not yet ready, or nil once all generated applications have converged.
+func applicationSetReady(appSet *application.ApplicationSet, apps []application.Application) error {
+	if c := applicationSetCondition(appSet, application.ApplicationSetConditionErrorOccurred); c != nil && c.Status == application.ApplicationSetConditionStatusTrue {
+		return fmt.Errorf("application set reported an error: %s", c.Message)
+	}
+
+	if c := applicationSetCondition(appSet, application.ApplicationSetConditionResourcesUpToDate); c == nil || c.Status != application.ApplicationSetConditionStatusTrue {
+		return fmt.Errorf("application set resources are not yet up to date")
+	}
+
+	if appSet.Spec.Strategy != nil {
+		if c := applicationSetCondition(appSet, application.ApplicationSetConditionRolloutProgressing); c != nil && c.Status == application.ApplicationSetConditionStatusTrue {
+			return fmt.Errorf("progressive sync is in progress: %s", c.Message)
+		}
+
+		for _, s := range appSet.Status.ApplicationStatus {
+			if s.Status != application.ProgressiveSyncHealthy {
+				return fmt.Errorf("expected application %s in step %s to be Healthy but was %s", s.Application, s.Step, s.Status)
+			}
+		}
+	}
+
+	for _, app := range apps {
+		if !isOwnedByApplicationSet(app.ObjectMeta, appSet) {
+			continue
+		}
+
+		if app.Status.Health.Status != health.HealthStatusHealthy {
+			return fmt.Errorf("expected application %s health status to be healthy but was %s", app.Name, app.Status.Health.Status)
+		}
+
+		if app.Status.Sync.Status != application.SyncStatusCodeSynced {
+			return fmt.Errorf("expected application %s sync status to be synced but was %s", app.Name, app.Status.Sync.Status)
+		}
+	}
+
+	return nil
+}
+
+func applicationSetCondition(appSet *application.ApplicationSet, t application.ApplicationSetConditionType) *application.ApplicationSetCondition {
+	for i := range appSet.Status.Conditions {
+		if appSet.Status.Conditions[i].Type == t {
+			return &appSet.Status.Conditions[i]
+		}
+	}
+
+	return nil
+}
+
+// applicationSetOwnerReference returns the owner reference of the application
+// set that generated the given object, if any.
+func applicationSetOwnerReference(objectMeta metav1.ObjectMeta) *metav1.OwnerReference {
+	for i, o := range objectMeta.OwnerReferences {
+		if o.Kind == application.ApplicationSetSchemaGroupVersionKind.Kind {
+			return &objectMeta.OwnerReferences[i]
+		}
+	}
+
+	return nil
+}
+
+// isOwnedByApplicationSet checks whether the object carries an owner reference
+// pointing to the given application set.
+func isOwnedByApplicationSet(objectMeta metav1.ObjectMeta, appSet *application.ApplicationSet) bool {
+	for _, o := range objectMeta.OwnerReferences {
+		if o.Kind != application.ApplicationSetSchemaGroupVersionKind.Kind {
+			continue
+		}
+
+		if o.UID == appSet.UID || (o.UID == "" && o.Name == appSet.Name) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -4,11 +4,17 @@ import (
 	"fmt"
 	"reflect"
 	"regexp"
+	"strings"
 	"testing"
 
 	"github.com/argoproj-labs/terraform-provider-argocd/internal/features"
+	"github.com/argoproj/argo-cd/gitops-engine/pkg/health"
+	application "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 func TestAccArgoCDApplicationSet_clusters(t *testing.T) {
@@ -525,6 +531,35 @@ func TestAccArgoCDApplicationSet_mergeNested(t *testing.T) {
 	})
 }
 
+func TestAccArgoCDApplicationSet_scmProviderAWSCodeCommit(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t); testAccPreCheckFeatureSupported(t, features.ApplicationSet) },
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccArgoCDApplicationSet_scmProviderAWSCodeCommit(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(
+						"argocd_application_set.scm_aws_code_commit",
+						"metadata.0.uid",
+					),
+					resource.TestCheckResourceAttr(
+						"argocd_application_set.scm_aws_code_commit",
+						"spec.0.generator.0.scm_provider.0.aws_code_commit.0.tag_filter.0.key",
+						"organization",
+					),
+				),
+			},
+			{
+				ResourceName:            "argocd_application_set.scm_aws_code_commit",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"metadata.0.resource_version"},
+			},
+		},
+	})
+}
+
 func TestAccArgoCDApplicationSet_scmProviderAzureDevOps(t *testing.T) {
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { testAccPreCheck(t); testAccPreCheckFeatureSupported(t, features.ApplicationSet) },
@@ -743,6 +778,35 @@ func TestAccArgoCDApplicationSet_scmProviderWithFilters(t *testing.T) {
 	})
 }
 
+func TestAccArgoCDApplicationSet_pullRequestBitbucketCloud(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t); testAccPreCheckFeatureSupported(t, features.ApplicationSet) },
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccArgoCDApplicationSet_pullRequestBitbucketCloud(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(
+						"argocd_application_set.pr_bitbucket_cloud",
+						"metadata.0.uid",
+					),
+					resource.TestCheckResourceAttr(
+						"argocd_application_set.pr_bitbucket_cloud",
+						"spec.0.generator.0.pull_request.0.bitbucket_cloud.0.owner",
+						"myworkspace",
+					),
+				),
+			},
+			{
+				ResourceName:            "argocd_application_set.pr_bitbucket_cloud",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"metadata.0.resource_version"},
+			},
+		},
+	})
+}
+
 func TestAccArgoCDApplicationSet_pullRequestBitbucketServer(t *testing.T) {
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { testAccPreCheck(t); testAccPreCheckFeatureSupported(t, features.ApplicationSet) },
@@ -1041,6 +1105,23 @@ func TestAccArgoCDApplicationSet_goTemplate(t *testing.T) {
 	})
 }
 
+func TestAccArgoCDApplicationSet_goTemplateInvalid(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t); testAccPreCheckFeatureSupported(t, features.ApplicationSet) },
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccArgoCDApplicationSet_goTemplateInvalid("appset-go-template-{{.name", "missingkey=error"),
+				ExpectError: regexp.MustCompile("template.metadata.name: invalid go template"),
+			},
+			{
+				Config:      testAccArgoCDApplicationSet_goTemplateInvalid("appset-go-template-{{.name}}", "missingkey=explode"),
+				ExpectError: regexp.MustCompile("invalid go template option 'missingkey=explode'"),
+			},
+		},
+	})
+}
+
 func TestAccArgoCDApplicationSet_syncPolicy(t *testing.T) {
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { testAccPreCheck(t); testAccPreCheckFeatureSupported(t, features.ApplicationSet) },
@@ -1191,6 +1272,231 @@ func TestAccArgoCDApplicationSet_CustomNamespace(t *testing.T) {
 	})
 }
 
+func TestAccArgoCDApplicationSet_wait(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t); testAccPreCheckFeatureSupported(t, features.ApplicationSet) },
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccArgoCDApplicationSet_wait(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(
+						"argocd_application_set.wait",
+						"metadata.0.uid",
+					),
+					resource.TestCheckResourceAttr(
+						"argocd_application_set.wait",
+						"wait",
+						"true",
+					),
+				),
+			},
+			{
+				ResourceName:            "argocd_application_set.wait",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"metadata.0.resource_version", "wait"},
+			},
+		},
+	})
+}
+
+func TestApplicationSetReady(t *testing.T) {
+	t.Parallel()
+
+	appSet := &application.ApplicationSet{
+		ObjectMeta: metav1.ObjectMeta{Name: "test", UID: "1234"},
+		Status: application.ApplicationSetStatus{
+			Conditions: []application.ApplicationSetCondition{
+				{Type: application.ApplicationSetConditionResourcesUpToDate, Status: application.ApplicationSetConditionStatusTrue},
+			},
+		},
+	}
+
+	owned := metav1.ObjectMeta{
+		Name: "owned",
+		OwnerReferences: []metav1.OwnerReference{
+			{Kind: "ApplicationSet", Name: "test", UID: "1234"},
+		},
+	}
+
+	healthy := application.ApplicationStatus{
+		Health: application.AppHealthStatus{Status: health.HealthStatusHealthy},
+		Sync:   application.SyncStatus{Status: application.SyncStatusCodeSynced},
+	}
+
+	degraded := application.ApplicationStatus{
+		Health: application.AppHealthStatus{Status: health.HealthStatusDegraded},
+		Sync:   application.SyncStatus{Status: application.SyncStatusCodeSynced},
+	}
+
+	if err := applicationSetReady(appSet, []application.Application{{ObjectMeta: owned, Status: healthy}}); err != nil {
+		t.Fatalf("expected application set to be ready, got: %s", err)
+	}
+
+	if err := applicationSetReady(appSet, []application.Application{{ObjectMeta: owned, Status: degraded}}); err == nil {
+		t.Fatal("expected application set with degraded application not to be ready")
+	}
+
+	if err := applicationSetReady(appSet, []application.Application{{ObjectMeta: metav1.ObjectMeta{Name: "other"}, Status: degraded}}); err != nil {
+		t.Fatalf("expected applications not owned by the application set to be ignored, got: %s", err)
+	}
+
+	appSet.Spec.Strategy = &application.ApplicationSetStrategy{Type: "RollingSync"}
+	appSet.Status.ApplicationStatus = []application.ApplicationSetApplicationStatus{
+		{Application: "owned", Status: application.ProgressiveSyncProgressing, Step: "1"},
+	}
+
+	if err := applicationSetReady(appSet, []application.Application{{ObjectMeta: owned, Status: healthy}}); err == nil {
+		t.Fatal("expected application set with progressing rollout not to be ready")
+	}
+
+	appSet.Status.Conditions = append(appSet.Status.Conditions, application.ApplicationSetCondition{
+		Type:    application.ApplicationSetConditionErrorOccurred,
+		Status:  application.ApplicationSetConditionStatusTrue,
+		Message: "boom",
+	})
+
+	if err := applicationSetReady(appSet, nil); err == nil {
+		t.Fatal("expected application set with error condition not to be ready")
+	}
+}
+
+func TestApplicationSetApplicationQuery(t *testing.T) {
+	t.Parallel()
+
+	appSet := &application.ApplicationSet{
+		ObjectMeta: metav1.ObjectMeta{Name: "guestbook", Namespace: "argocd"},
+	}
+	appSet.Spec.Template.Labels = map[string]string{
+		"team":            "a",
+		"env":             "prod",
+		"cluster":         "{{name}}",
+		"{{.owner}}-name": "x",
+	}
+	appSet.Spec.Template.Spec.Project = "default"
+
+	q := applicationSetApplicationQuery(appSet)
+
+	if q.GetAppNamespace() != "argocd" {
+		t.Errorf("expected app namespace argocd, got %s", q.GetAppNamespace())
+	}
+
+	if !reflect.DeepEqual(q.Projects, []string{"default"}) {
+		t.Errorf("expected projects [default], got %v", q.Projects)
+	}
+
+	if q.GetSelector() != "env=prod,team=a" {
+		t.Errorf("expected selector env=prod,team=a, got %s", q.GetSelector())
+	}
+
+	appSet.Spec.Template.Spec.Project = "{{.project}}"
+	appSet.Spec.TemplatePatch = new(string)
+
+	q = applicationSetApplicationQuery(appSet)
+
+	if q.Projects != nil || q.Selector != nil {
+		t.Errorf("expected no projects nor selector with a template patch, got %v and %s", q.Projects, q.GetSelector())
+	}
+}
+
+func TestApplicationSetConditionDiagnostics(t *testing.T) {
+	t.Parallel()
+
+	appSet := &application.ApplicationSet{
+		ObjectMeta: metav1.ObjectMeta{Name: "test"},
+		Status: application.ApplicationSetStatus{
+			Conditions: []application.ApplicationSetCondition{
+				{Type: application.ApplicationSetConditionResourcesUpToDate, Status: application.ApplicationSetConditionStatusTrue},
+				{Type: application.ApplicationSetConditionParametersGenerated, Status: application.ApplicationSetConditionStatusFalse},
+			},
+		},
+	}
+
+	if diags := applicationSetConditionDiagnostics(appSet); len(diags) != 0 {
+		t.Fatalf("expected no diagnostics, got %v", diags)
+	}
+
+	appSet.Status.Conditions = append(appSet.Status.Conditions, application.ApplicationSetCondition{
+		Type:    application.ApplicationSetConditionErrorOccurred,
+		Status:  application.ApplicationSetConditionStatusTrue,
+		Reason:  application.ApplicationSetReasonRenderTemplateParamsError,
+		Message: "failed to execute go template",
+	})
+
+	// Errors are only warnings after apply, as they may predate the change;
+	// waiting for the application set fails on them.
+	diags := applicationSetConditionDiagnostics(appSet)
+	if len(diags) != 1 || diags[0].Severity != diag.Warning || diags[0].Detail != "failed to execute go template" {
+		t.Fatalf("expected a single warning, got %v", diags)
+	}
+
+	if err := applicationSetReady(appSet, nil); err == nil || !strings.Contains(err.Error(), "failed to execute go template") {
+		t.Errorf("expected application set not to be ready, got: %v", err)
+	}
+}
+
+func TestValidateApplicationSetGoTemplates_generators(t *testing.T) {
+	t.Parallel()
+
+	generators := []application.ApplicationSetGenerator{
+		{
+			Clusters: &application.ClusterGenerator{
+				Values: map[string]string{"revision": "{{.metadata.labels.revision}}"},
+				Template: application.ApplicationSetTemplate{
+					ApplicationSetTemplateMeta: application.ApplicationSetTemplateMeta{Name: "{{.name"},
+				},
+			},
+		},
+		{
+			Matrix: &application.MatrixGenerator{
+				Generators: []application.ApplicationSetNestedGenerator{
+					{
+						List: &application.ListGenerator{
+							Elements: []apiextensionsv1.JSON{{Raw: []byte(`{"values":"{{ item.name }}"}`)}},
+						},
+					},
+					{
+						Git: &application.GitGenerator{
+							RepoURL:  "https://github.com/argoproj/argo-cd",
+							Revision: "{{.values.revision",
+						},
+					},
+					{
+						Plugin: &application.PluginGenerator{
+							ConfigMapRef: application.PluginConfigMapRef{Name: "plugin"},
+							Input: application.PluginInput{
+								Parameters: application.PluginParameters{
+									"values": {Raw: []byte(`"{% if enabled %}{{ item.name }}{% endif %}"`)},
+								},
+							},
+						},
+					},
+					{
+						List: &application.ListGenerator{
+							ElementsYaml: "- values: '{{ item.name }}'",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	errs := validateApplicationSetGeneratorGoTemplates("generator", generators, []string{"missingkey=error"})
+	if len(errs) != 2 {
+		t.Fatalf("expected two errors, got %v", errs)
+	}
+
+	for i, prefix := range []string{
+		"generator[0].clusters.template.metadata.name: invalid go template",
+		"generator[1].matrix.generators[1].git.revision: invalid go template",
+	} {
+		if !strings.HasPrefix(errs[i].Error(), prefix) {
+			t.Errorf("unexpected error: %s", errs[i])
+		}
+	}
+}
+
 func TestUpgradeSchemaApplicationSet_V0V1_Default_NoChange(t *testing.T) {
 	t.Parallel()
 
@@ -2538,6 +2844,57 @@ resource "argocd_application_set" "merge_nested_invalid" {
 }`
 }
 
+func testAccArgoCDApplicationSet_scmProviderAWSCodeCommit() string {
+	return `
+resource "argocd_application_set" "scm_aws_code_commit" {
+	metadata {
+		name = "scm-aws-code-commit"
+	}
+
+	spec {
+		generator {
+			scm_provider {
+				aws_code_commit {
+					all_branches = true
+					region       = "us-east-1"
+					role         = "arn:aws:iam::111111111111:role/argocd-commit-discovery"
+
+					tag_filter {
+						key   = "organization"
+						value = "platform-engineering"
+					}
+
+					tag_filter {
+						key = "argo-ready"
+					}
+				}
+			}
+		}
+
+		template {
+			metadata {
+				name = "{{repository}}"
+			}
+
+			spec {
+				project = "default"
+
+				source {
+					repo_url        = "{{url}}"
+					path            = "kubernetes/"
+					target_revision = "{{branch}}"
+				}
+
+				destination {
+					server    = "https://kubernetes.default.svc"
+					namespace = "default"
+				}
+			}
+		}
+	}
+}`
+}
+
 func testAccArgoCDApplicationSet_scmProviderAzureDevOps() string {
 	return `
 resource "argocd_application_set" "scm_ado" {
@@ -2892,6 +3249,56 @@ resource "argocd_application_set" "scm_filters" {
 }`
 }
 
+func testAccArgoCDApplicationSet_pullRequestBitbucketCloud() string {
+	return `
+resource "argocd_application_set" "pr_bitbucket_cloud" {
+	metadata {
+		name = "pr-bitbucket-cloud"
+	}
+
+	spec {
+		generator {
+			pull_request {
+				continue_on_repo_not_found_error = true
+
+				bitbucket_cloud {
+					owner = "myworkspace"
+					repo  = "myrepository"
+
+					bearer_token {
+						token_ref {
+							secret_name = "mytoken"
+							key         = "token"
+						}
+					}
+				}
+			}
+		}
+
+		template {
+			metadata {
+				name = "myapp-{{branch}}-{{number}}"
+			}
+
+			spec {
+				project = "default"
+
+				source {
+					repo_url        = "https://bitbucket.org/myworkspace/myrepository.git"
+					path            = "kubernetes/"
+					target_revision = "{{head_sha}}"
+				}
+
+				destination {
+					server    = "https://kubernetes.default.svc"
+					namespace = "default"
+				}
+			}
+		}
+	}
+}`
+}
+
 func testAccArgoCDApplicationSet_pullRequestBitbucketServer() string {
 	return `
 resource "argocd_application_set" "pr_bitbucket_server" {
@@ -3346,6 +3753,45 @@ resource "argocd_application_set" "go_template" {
 }`
 }
 
+func testAccArgoCDApplicationSet_goTemplateInvalid(name, option string) string {
+	return fmt.Sprintf(`
+resource "argocd_application_set" "go_template_invalid" {
+	metadata {
+		name = "go-template-invalid"
+	}
+
+	spec {
+		generator {
+			clusters {}
+		}
+
+		go_template = true
+		go_template_options = [
+			"%s"
+		]
+
+		template {
+			metadata {
+				name = "%s"
+			}
+
+			spec {
+				source {
+					repo_url        = "https://github.com/argoproj/argo-cd/"
+					target_revision = "HEAD"
+					path            = "test/e2e/testdata/guestbook"
+				}
+
+				destination {
+					server    = "{{.server}}"
+					namespace = "default"
+				}
+			}
+		}
+	}
+}`, option, name)
+}
+
 func testAccArgoCDApplicationSet_syncPolicy() string {
 	return `
 resource "argocd_application_set" "sync_policy" {
@@ -3579,6 +4025,60 @@ resource "argocd_application_set" "template_patch" {
 }`
 }
 
+func testAccArgoCDApplicationSet_wait() string {
+	return `
+resource "argocd_application_set" "wait" {
+	metadata {
+		name = "wait"
+	}
+
+	wait = true
+
+	spec {
+		generator {
+			list {
+				elements = [
+					{
+						cluster = "in-cluster"
+						url     = "https://kubernetes.default.svc"
+					}
+				]
+			}
+		}
+
+		template {
+			metadata {
+				name = "{{cluster}}-wait"
+			}
+
+			spec {
+				project = "default"
+
+				source {
+					repo_url        = "https://github.com/argoproj/argocd-example-apps"
+					target_revision = "HEAD"
+					path            = "guestbook"
+				}
+
+				destination {
+					server    = "{{url}}"
+					namespace = "wait"
+				}
+
+				sync_policy {
+					automated {
+						prune     = true
+						self_heal = true
+					}
+
+					sync_options = ["CreateNamespace=true"]
+				}
+			}
+		}
+	}
+}`
+}
+
 func testAccArgoCDApplicationSetCustomNamespace(name string) string {
 	return fmt.Sprintf(`
 resource "argocd_project" "custom_namespace" {
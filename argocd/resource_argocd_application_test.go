@@ -1,14 +1,19 @@
 package argocd
 
 import (
+	"context"
 	"fmt"
 	"regexp"
 	"strconv"
 	"testing"
+	"time"
 
 	"github.com/argoproj-labs/terraform-provider-argocd/internal/features"
+	applicationClient "github.com/argoproj/argo-cd/v3/pkg/apiclient/application"
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 func TestAccArgoCDApplication(t *testing.T) {
@@ -1337,6 +1342,233 @@ func TestAccArgoCDApplication_Sync(t *testing.T) {
 	})
 }
 
+func TestAccArgoCDApplication_ApplicationSetOwned(t *testing.T) {
+	name := acctest.RandomWithPrefix("test-acc")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccArgoCDApplicationApplicationSetOwned(name),
+				ExpectError: regexp.MustCompile("is managed by application set " + name),
+			},
+		},
+	})
+}
+
+func TestAccArgoCDApplication_Adopt(t *testing.T) {
+	name := acctest.RandomWithPrefix("test-acc")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() {
+					testAccCreateApplication(t, name, nil)
+				},
+				Config:      testAccArgoCDApplicationAdopt(name, false),
+				ExpectError: regexp.MustCompile("application .* already exists"),
+			},
+			{
+				Config: testAccArgoCDApplicationAdopt(name, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"argocd_application.adopt",
+						"adopt_existing",
+						"true",
+					),
+					resource.TestCheckResourceAttr(
+						"argocd_application.adopt",
+						"spec.0.source.0.target_revision",
+						"0.33.0",
+					),
+					resource.TestCheckResourceAttr(
+						"argocd_application.adopt",
+						"metadata.0.labels.acceptance",
+						"true",
+					),
+				),
+			},
+			{
+				ResourceName:            "argocd_application.adopt",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"adopt_existing", "wait", "cascade", "metadata.0.generation", "metadata.0.resource_version", "status", "validate"},
+			},
+		},
+	})
+}
+
+func TestAccArgoCDApplication_ExistingIdentical(t *testing.T) {
+	name := acctest.RandomWithPrefix("test-acc")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() {
+					testAccCreateApplication(t, name, map[string]string{"acceptance": "true"})
+				},
+				Config: testAccArgoCDApplicationAdopt(name, false),
+				Check: resource.TestCheckResourceAttr(
+					"argocd_application.adopt",
+					"spec.0.source.0.target_revision",
+					"0.33.0",
+				),
+			},
+		},
+	})
+}
+
+// testAccCreateApplication creates an application outside of Terraform, with
+// the same spec as testAccArgoCDApplicationAdopt.
+func testAccCreateApplication(t *testing.T, name string, labels map[string]string) {
+	t.Helper()
+
+	si, err := getServerInterface()
+	if err != nil {
+		t.Fatalf("failed to get server interface: %s", err.Error())
+	}
+
+	ctx, cancel := context.WithTimeout(t.Context(), 120*time.Second)
+	defer cancel()
+
+	_, err = si.ApplicationClient.Create(ctx, &applicationClient.ApplicationCreateRequest{
+		Application: &v1alpha1.Application{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: "argocd",
+				Labels:    labels,
+			},
+			Spec: v1alpha1.ApplicationSpec{
+				Source: &v1alpha1.ApplicationSource{
+					RepoURL:        "https://kubernetes-sigs.github.io/descheduler",
+					Chart:          "descheduler",
+					TargetRevision: "0.33.0",
+				},
+				Destination: v1alpha1.ApplicationDestination{
+					Server:    "https://kubernetes.default.svc",
+					Namespace: name,
+				},
+				Project: "default",
+			},
+		},
+	})
+	if err != nil {
+		t.Fatalf("failed to create application '%s': %s", name, err.Error())
+	}
+}
+
+func testAccArgoCDApplicationApplicationSetOwned(name string) string {
+	return fmt.Sprintf(`
+resource "argocd_application_set" "owner" {
+  metadata {
+    name = "%[1]s"
+  }
+
+  wait = true
+
+  spec {
+    generator {
+      list {
+        elements = [
+          {
+            name = "%[1]s"
+          }
+        ]
+      }
+    }
+
+    template {
+      metadata {
+        name = "{{name}}"
+      }
+
+      spec {
+        project = "default"
+
+        source {
+          repo_url        = "https://github.com/argoproj/argocd-example-apps"
+          target_revision = "HEAD"
+          path            = "guestbook"
+        }
+
+        destination {
+          server    = "https://kubernetes.default.svc"
+          namespace = "{{name}}"
+        }
+
+        sync_policy {
+          automated {
+            prune     = true
+            self_heal = true
+          }
+
+          sync_options = ["CreateNamespace=true"]
+        }
+      }
+    }
+  }
+}
+
+resource "argocd_application" "owned" {
+  metadata {
+    name      = "%[1]s"
+    namespace = "argocd"
+  }
+
+  spec {
+    source {
+      repo_url        = "https://github.com/argoproj/argocd-example-apps"
+      target_revision = "HEAD"
+      path            = "guestbook"
+    }
+
+    destination {
+      server    = "https://kubernetes.default.svc"
+      namespace = "%[1]s"
+    }
+  }
+
+  adopt_existing = true
+
+  depends_on = [argocd_application_set.owner]
+}
+`, name)
+}
+
+func testAccArgoCDApplicationAdopt(name string, adopt bool) string {
+	return fmt.Sprintf(`
+resource "argocd_application" "adopt" {
+  metadata {
+    name      = "%[1]s"
+    namespace = "argocd"
+    labels = {
+      acceptance = "true"
+    }
+  }
+
+  spec {
+    source {
+      repo_url        = "https://kubernetes-sigs.github.io/descheduler"
+      chart           = "descheduler"
+      target_revision = "0.33.0"
+    }
+
+    destination {
+      server    = "https://kubernetes.default.svc"
+      namespace = "%[1]s"
+    }
+  }
+
+  adopt_existing = %[2]t
+}
+`, name, adopt)
+}
+
 func testAccArgoCDApplicationSync(name string, sync bool) string {
 	return fmt.Sprintf(`
 resource "argocd_application" "sync" {
@@ -3,11 +3,26 @@ package argocd
 import (
 	"context"
 	"fmt"
+	"hash/fnv"
+	"reflect"
+	"slices"
 	"strings"
+	"time"
 
+	"github.com/argoproj-labs/terraform-provider-argocd/internal/clusterid"
+	"github.com/argoproj-labs/terraform-provider-argocd/internal/diagnostics"
 	clusterClient "github.com/argoproj/argo-cd/v3/pkg/apiclient/cluster"
+	projectClient "github.com/argoproj/argo-cd/v3/pkg/apiclient/project"
+	application "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/util/clusterauth"
+	"github.com/argoproj/argo-cd/v3/util/glob"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
 )
 
 func resourceArgoCDCluster() *schema.Resource {
@@ -20,7 +35,12 @@ func resourceArgoCDCluster() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
-		Schema: clusterSchema(),
+		Schema:        clusterSchema(),
+		CustomizeDiff: resourceArgoCDClusterCustomizeDiff,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+		},
 	}
 }
 
@@ -35,6 +55,11 @@ func resourceArgoCDClusterCreate(ctx context.Context, d *schema.ResourceData, me
 		return errorToDiagnostics("failed to expand cluster", err)
 	}
 
+	if d.GetRawConfig().GetAttr("shard").IsNull() && si.config.ShardCount.ValueInt64() > 0 {
+		shard := clusterShard(cluster.Server, si.config.ShardCount.ValueInt64())
+		cluster.Shard = &shard
+	}
+
 	// Need a full lock here to avoid race conditions between List existing clusters and creating a new one
 	tokenMutexClusters.Lock()
 
@@ -55,39 +80,74 @@ func resourceArgoCDClusterCreate(ctx context.Context, d *schema.ResourceData, me
 		return errorToDiagnostics(fmt.Sprintf("failed to list existing clusters when creating cluster %s", cluster.Server), err)
 	}
 
+	adopted := false
+
 	// Here we will filter ourselves on the list so that we are backward compatible for argo-cd server with version < v2.8.0 (see coment above)
 	if len(existingClusters.Items) > 0 {
 		for _, existingCluster := range existingClusters.Items {
 			if rtrimmedServer == strings.TrimRight(existingCluster.Server, "/") {
+				if d.Get("adopt_existing").(bool) {
+					adopted = true
+					break
+				}
+
 				tokenMutexClusters.Unlock()
 
 				return []diag.Diagnostic{
 					{
 						Severity: diag.Error,
 						Summary:  fmt.Sprintf("cluster with server address %s already exists", cluster.Server),
+						Detail:   "Set `adopt_existing = true` to take over the existing cluster or import it using `terraform import`.",
 					},
 				}
 			}
 		}
 	}
 
+	// RBAC is only bootstrapped once the cluster is known not to conflict with
+	// an existing one, using the configured credentials to roll it back
+	b, bootstrap := expandClusterBootstrapRBAC(d.Get("bootstrap_rbac"))
+	credentials := cluster.DeepCopy()
+
+	if bootstrap {
+		if err = b.install(ctx, cluster); err != nil {
+			tokenMutexClusters.Unlock()
+
+			diags := errorToDiagnostics(fmt.Sprintf("failed to bootstrap RBAC in cluster %s", cluster.Server), err)
+
+			return append(diags, rollbackClusterBootstrapRBAC(ctx, b, credentials)...)
+		}
+	}
+
 	c, err := si.ClusterClient.Create(ctx, &clusterClient.ClusterCreateRequest{
-		Cluster: cluster, Upsert: false,
+		Cluster: cluster, Upsert: adopted,
 	})
 	tokenMutexClusters.Unlock()
 
 	if err != nil {
-		return argoCDAPIError("create", "cluster", cluster.Server, err)
+		diags := pluginSDKDiags(diagnostics.CreateError("cluster", cluster.Server, err))
+		if bootstrap {
+			diags = append(diags, rollbackClusterBootstrapRBAC(ctx, b, credentials)...)
+		}
+
+		return diags
 	}
 
 	// Check if the name has been defaulted to server (when omitted)
-	if c.Name != "" && c.Name != c.Server {
-		d.SetId(fmt.Sprintf("%s/%s", c.Server, c.Name))
-	} else {
-		d.SetId(c.Server)
+	d.SetId(clusterid.ID(c))
+
+	if d.Get("verify_connection").(bool) {
+		if err = verifyClusterConnection(ctx, si, d, d.Timeout(schema.TimeoutCreate)); err != nil {
+			return errorToDiagnostics(fmt.Sprintf("failed to verify connection to cluster %s", c.Server), err)
+		}
 	}
 
-	return resourceArgoCDClusterRead(ctx, d, meta)
+	diags := resourceArgoCDClusterRead(ctx, d, meta)
+	if adopted {
+		diags = append(diags, pluginSDKDiags(diagnostics.Adopted("cluster", cluster.Server))...)
+	}
+
+	return append(diags, clusterProjectDestinationDiagnostics(ctx, si, c)...)
 }
 
 func resourceArgoCDClusterRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -97,7 +157,7 @@ func resourceArgoCDClusterRead(ctx context.Context, d *schema.ResourceData, meta
 	}
 
 	tokenMutexClusters.RLock()
-	c, err := si.ClusterClient.Get(ctx, getClusterQueryFromID(d))
+	c, err := si.ClusterClient.Get(ctx, clusterid.Query(d.Id()))
 	tokenMutexClusters.RUnlock()
 
 	if err != nil {
@@ -177,15 +237,114 @@ func resourceArgoCDClusterUpdate(ctx context.Context, d *schema.ResourceData, me
 		return errorToDiagnostics(fmt.Sprintf("failed to expand cluster %s", d.Id()), err)
 	}
 
+	if d.HasChanges("bootstrap_rbac", "namespaces") {
+		ob, nb := d.GetChange("bootstrap_rbac")
+		ons, nns := d.GetChange("namespaces")
+
+		previous, wasBootstrapped := expandClusterBootstrapRBAC(ob)
+		current, isBootstrapped := expandClusterBootstrapRBAC(nb)
+
+		if wasBootstrapped {
+			previousNamespaces := expandStringList(ons.([]interface{}))
+
+			// The ServiceAccount is only removed when it is not reused, as its token
+			// Secret would otherwise be deleted after being handed to ArgoCD
+			if !isBootstrapped || previous.namespace != current.namespace {
+				err = previous.uninstall(ctx, cluster, previousNamespaces)
+			} else {
+				var clientset kubernetes.Interface
+
+				if clientset, err = previous.clientset(cluster); err == nil {
+					err = pruneClusterRBAC(ctx, clientset, previousNamespaces, expandStringList(nns.([]interface{})))
+				}
+			}
+
+			if err != nil {
+				return errorToDiagnostics(fmt.Sprintf("failed to remove bootstrapped RBAC from cluster %s", cluster.Server), err)
+			}
+		}
+	}
+
+	if d.GetRawConfig().GetAttr("shard").IsNull() && si.config.ShardCount.ValueInt64() > 0 {
+		shard := clusterShard(cluster.Server, si.config.ShardCount.ValueInt64())
+		cluster.Shard = &shard
+	}
+
+	ot, nt := d.GetChange("rotation_triggers")
+	rotate := clusterRotationTriggered(ot.(map[string]interface{}), nt.(map[string]interface{}))
+	b, bootstrapped := expandClusterBootstrapRBAC(d.Get("bootstrap_rbac"))
+
+	// ArgoCD holds the token of the bootstrapped ServiceAccount, which is only
+	// requested again when the RBAC or the credentials used to install it
+	// change. Likewise, once rotated, the token held by ArgoCD differs from the
+	// configured credentials, which are then only sent when they change, even
+	// after the triggers are removed.
+	var updateConfig bool
+	if bootstrapped {
+		updateConfig = d.HasChanges("bootstrap_rbac", "namespaces", "config", "kubeconfig")
+	} else {
+		updateConfig = (len(ot.(map[string]interface{})) == 0 && len(nt.(map[string]interface{})) == 0) || d.HasChanges("config", "kubeconfig", "bootstrap_rbac")
+	}
+
+	if bootstrapped && updateConfig {
+		if err = b.install(ctx, cluster); err != nil {
+			return errorToDiagnostics(fmt.Sprintf("failed to bootstrap RBAC in cluster %s", cluster.Server), err)
+		}
+	}
+
+	req := &clusterClient.ClusterUpdateRequest{
+		Cluster:       cluster,
+		UpdatedFields: clusterUpdatedFields(updateConfig),
+	}
+
 	tokenMutexClusters.Lock()
-	_, err = si.ClusterClient.Update(ctx, &clusterClient.ClusterUpdateRequest{Cluster: cluster})
+	_, err = si.ClusterClient.Update(ctx, req)
 	tokenMutexClusters.Unlock()
 
 	if err != nil {
 		return argoCDAPIError("update", "cluster", cluster.Server, err)
 	}
 
-	return resourceArgoCDClusterRead(ctx, d, meta)
+	if rotate {
+		tokenMutexClusters.Lock()
+		_, err = si.ClusterClient.RotateAuth(ctx, clusterid.Query(d.Id()))
+		tokenMutexClusters.Unlock()
+
+		if err != nil {
+			return argoCDAPIError("rotate credentials of", "cluster", cluster.Server, err)
+		}
+	}
+
+	if d.Get("verify_connection").(bool) {
+		if err = verifyClusterConnection(ctx, si, d, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return errorToDiagnostics(fmt.Sprintf("failed to verify connection to cluster %s", cluster.Server), err)
+		}
+	}
+
+	diags := resourceArgoCDClusterRead(ctx, d, meta)
+	if d.HasChanges("project", "server", "name") {
+		diags = append(diags, clusterProjectDestinationDiagnostics(ctx, si, cluster)...)
+	}
+
+	return diags
+}
+
+// clusterRotationTriggered returns whether the credentials of the cluster are
+// to be rotated, i.e. when its rotation triggers are set and changed. Removing
+// the triggers does not rotate the credentials.
+func clusterRotationTriggered(o, n map[string]interface{}) bool {
+	return len(n) > 0 && !reflect.DeepEqual(o, n)
+}
+
+// clusterUpdatedFields returns the fields of a cluster managed by the resource,
+// leaving out its connection configuration unless it has to be sent to ArgoCD.
+func clusterUpdatedFields(updateConfig bool) []string {
+	fields := []string{"name", "namespaces", "shard", "clusterResources", "labels", "annotations", "project"}
+	if updateConfig {
+		fields = append(fields, "config")
+	}
+
+	return fields
 }
 
 func resourceArgoCDClusterDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -195,33 +354,526 @@ func resourceArgoCDClusterDelete(ctx context.Context, d *schema.ResourceData, me
 	}
 
 	tokenMutexClusters.Lock()
-	_, err := si.ClusterClient.Delete(ctx, getClusterQueryFromID(d))
+	_, err := si.ClusterClient.Delete(ctx, clusterid.Query(d.Id()))
 	tokenMutexClusters.Unlock()
 
+	if err != nil && !strings.Contains(err.Error(), "NotFound") {
+		return argoCDAPIError("delete", "cluster", d.Id(), err)
+	}
+
+	if b, ok := expandClusterBootstrapRBAC(d.Get("bootstrap_rbac")); ok {
+		cluster, err := expandCluster(d)
+		if err != nil {
+			return errorToDiagnostics(fmt.Sprintf("failed to expand cluster %s", d.Id()), err)
+		}
+
+		if err = b.uninstall(ctx, cluster, cluster.Namespaces); err != nil {
+			return errorToDiagnostics(fmt.Sprintf("failed to remove bootstrapped RBAC from cluster %s", cluster.Server), err)
+		}
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+func resourceArgoCDClusterCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if err := customizeClusterKubeconfigServer(d); err != nil {
+		return err
+	}
+
+	if err := validateClusterProject(ctx, d, meta.(*ServerInterface)); err != nil {
+		return err
+	}
+
+	if d.HasChange("project") {
+		var err error
+		if d.NewValueKnown("project") {
+			err = d.SetNew("project_scoped", d.Get("project").(string) != "")
+		} else {
+			err = d.SetNewComputed("project_scoped")
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+
+	if !d.GetRawConfig().GetAttr("shard").IsNull() {
+		return nil
+	}
+
+	shard := ""
+
+	if n := meta.(*ServerInterface).config.ShardCount.ValueInt64(); n > 0 {
+		// The server address is only known at apply time when read from the kubeconfig
+		if !d.NewValueKnown("server") {
+			return d.SetNewComputed("shard")
+		}
+
+		shard = convertInt64ToString(clusterShard(d.Get("server").(string), n))
+	}
+
+	// Without a configured shard, the shard is removed unless one is assigned
+	if !d.NewValueKnown("shard") || d.Get("shard").(string) != shard {
+		return d.SetNew("shard", shard)
+	}
+
+	return nil
+}
+
+// customizeClusterKubeconfigServer plans the server address read from the
+// kubeconfig when `server` is not configured, so that the cluster is replaced
+// when the kubeconfig points to another server.
+func customizeClusterKubeconfigServer(d *schema.ResourceDiff) error {
+	if !d.GetRawConfig().GetAttr("server").IsNull() || !d.NewValueKnown("kubeconfig") {
+		return nil
+	}
+
+	l, ok := d.Get("kubeconfig").([]interface{})
+	if !ok || len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	k := l[0].(map[string]interface{})
+
+	server, err := clusterKubeconfigServer(k["raw"].(string), k["context"].(string))
 	if err != nil {
+		return fmt.Errorf("kubeconfig: %w", err)
+	}
+
+	if o, _ := d.GetChange("server"); strings.TrimRight(o.(string), "/") == strings.TrimRight(server, "/") {
+		return nil
+	}
+
+	if err = d.SetNew("server", server); err != nil {
+		return err
+	}
+
+	if d.Id() == "" {
+		return nil
+	}
+
+	return d.ForceNew("server")
+}
+
+// validateClusterProject checks that the project the cluster is scoped to
+// exists. Projects that are not known yet, e.g. because they are created in the
+// same run, are not checked.
+func validateClusterProject(ctx context.Context, d *schema.ResourceDiff, si *ServerInterface) error {
+	if !d.HasChange("project") || !d.NewValueKnown("project") {
+		return nil
+	}
+
+	name := d.Get("project").(string)
+	if name == "" {
+		return nil
+	}
+
+	if diags := si.InitClients(ctx); diags.HasError() {
+		return fmt.Errorf("failed to initialize clients: %s", diags.Errors()[0].Detail())
+	}
+
+	if _, err := si.ProjectClient.Get(ctx, &projectClient.ProjectQuery{Name: name}); err != nil {
 		if strings.Contains(err.Error(), "NotFound") {
-			d.SetId("")
+			return fmt.Errorf("project %s does not exist, reference the argocd_project resource if the project is created in the same configuration", name)
+		}
+
+		return fmt.Errorf("failed to read project %s: %w", name, err)
+	}
+
+	return nil
+}
+
+// clusterProjectDestinationDiagnostics warns when the project the cluster is
+// scoped to has no destination allowing the cluster.
+func clusterProjectDestinationDiagnostics(ctx context.Context, si *ServerInterface, c *application.Cluster) diag.Diagnostics {
+	if c.Project == "" {
+		return nil
+	}
+
+	p, err := si.ProjectClient.Get(ctx, &projectClient.ProjectQuery{Name: c.Project})
+	if err != nil {
+		return []diag.Diagnostic{
+			{
+				Severity: diag.Warning,
+				Summary:  fmt.Sprintf("failed to read project %s of cluster %s", c.Project, c.Server),
+				Detail:   err.Error(),
+			},
+		}
+	}
+
+	if projectDestinationsAllowCluster(p.Spec.Destinations, c) {
+		return nil
+	}
+
+	return []diag.Diagnostic{
+		{
+			Severity: diag.Warning,
+			Summary:  fmt.Sprintf("no destination of project %s matches cluster %s", c.Project, c.Server),
+			Detail:   "Applications of the project will not be able to deploy to the cluster until a destination matching its server address or name is added to the project.",
+		},
+	}
+}
+
+// projectDestinationsAllowCluster returns whether any of the destinations
+// matches the server address or name of the cluster, regardless of namespaces.
+func projectDestinationsAllowCluster(destinations []application.ApplicationDestination, c *application.Cluster) bool {
+	server := strings.TrimRight(c.Server, "/")
+
+	for _, dst := range destinations {
+		// Deny patterns never allow a destination by themselves
+		if strings.HasPrefix(dst.Server, "!") || strings.HasPrefix(dst.Name, "!") {
+			continue
+		}
+
+		if (dst.Server != "" && glob.Match(strings.TrimRight(dst.Server, "/"), server)) || (dst.Name != "" && c.Name != "" && glob.Match(dst.Name, c.Name)) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// clusterShard deterministically assigns one of shardCount shards to the
+// cluster with the given server address using rendezvous hashing, so that
+// changing the number of shards only moves the clusters from or to the shards
+// being removed or added.
+func clusterShard(server string, shardCount int64) int64 {
+	server = strings.TrimRight(server, "/")
+
+	var (
+		shard  int64
+		weight uint64
+	)
+
+	for i := int64(0); i < shardCount; i++ {
+		h := fnv.New64a()
+		_, _ = fmt.Fprintf(h, "%s#%d", server, i)
+
+		// Finalize the hash to spread the weights of similar keys
+		w := h.Sum64()
+		w ^= w >> 33
+		w *= 0xff51afd7ed558ccd
+		w ^= w >> 33
+
+		if i == 0 || w > weight {
+			shard, weight = i, w
+		}
+	}
+
+	return shard
+}
+
+// verifyClusterConnection requests ArgoCD to refresh the state of the cluster
+// and waits for the connection to the cluster to be successful.
+func verifyClusterConnection(ctx context.Context, si *ServerInterface, d *schema.ResourceData, timeout time.Duration) error {
+	q := clusterid.Query(d.Id())
+
+	tokenMutexClusters.Lock()
+	c, err := si.ClusterClient.InvalidateCache(ctx, q)
+	tokenMutexClusters.Unlock()
+
+	if err != nil {
+		return fmt.Errorf("failed to invalidate cache: %w", err)
+	}
+
+	requestedAt := time.Now().Truncate(time.Second)
+	if c.RefreshRequestedAt != nil {
+		requestedAt = c.RefreshRequestedAt.Time
+	}
+
+	return retry.RetryContext(ctx, timeout, func() *retry.RetryError {
+		tokenMutexClusters.RLock()
+		c, err := si.ClusterClient.Get(ctx, q)
+		tokenMutexClusters.RUnlock()
+
+		if err != nil {
+			return retry.NonRetryableError(err)
+		}
+
+		cs := c.Info.ConnectionState
+		if cs.Status == application.ConnectionStatusSuccessful {
 			return nil
 		}
 
-		return argoCDAPIError("delete", "cluster", d.Id(), err)
+		err = fmt.Errorf("connection status is %q: %s", cs.Status, cs.Message)
+
+		// A failure recorded before the refresh may relate to a previous
+		// configuration of the cluster
+		if cs.Status == application.ConnectionStatusFailed && cs.ModifiedAt != nil && !cs.ModifiedAt.Time.Before(requestedAt) {
+			return retry.NonRetryableError(err)
+		}
+
+		return retry.RetryableError(err)
+	})
+}
+
+// rollbackClusterBootstrapRBAC removes the RBAC installed for a cluster that
+// could not be created.
+func rollbackClusterBootstrapRBAC(ctx context.Context, b clusterBootstrapRBAC, cluster *application.Cluster) diag.Diagnostics {
+	if err := b.uninstall(ctx, cluster, cluster.Namespaces); err != nil {
+		return errorToDiagnostics(fmt.Sprintf("failed to remove bootstrapped RBAC from cluster %s", cluster.Server), err)
 	}
 
-	d.SetId("")
+	return nil
+}
+
+// clusterBootstrapTokenTimeout is the time to wait for the token Secret of the
+// bootstrapped ServiceAccount to be populated.
+const clusterBootstrapTokenTimeout = 30 * time.Second
+
+// clusterBootstrapRBAC holds the settings of the bootstrap_rbac block.
+type clusterBootstrapRBAC struct {
+	namespace string
+	server    string
+}
+
+func expandClusterBootstrapRBAC(v interface{}) (clusterBootstrapRBAC, bool) {
+	l, ok := v.([]interface{})
+	if !ok || len(l) == 0 {
+		return clusterBootstrapRBAC{}, false
+	}
+
+	b := clusterBootstrapRBAC{namespace: "kube-system"}
+
+	if m, ok := l[0].(map[string]interface{}); ok {
+		if ns, ok := m["namespace"].(string); ok && ns != "" {
+			b.namespace = ns
+		}
+
+		if s, ok := m["server"].(string); ok {
+			b.server = s
+		}
+	}
+
+	return b, true
+}
+
+// clientset returns a Kubernetes client for the target cluster, authenticated
+// with the credentials configured on the resource.
+func (b clusterBootstrapRBAC) clientset(cluster *application.Cluster) (kubernetes.Interface, error) {
+	c := cluster.DeepCopy()
+	if b.server != "" {
+		c.Server = b.server
+	}
+
+	rc, err := c.RawRestConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	return kubernetes.NewForConfig(rc)
+}
+
+// install creates the ServiceAccount and RBAC in the target cluster and
+// replaces the credentials of the cluster with the token of the ServiceAccount.
+// The objects that did not exist beforehand are labelled as managed by the
+// provider, so that only those are removed by uninstall.
+func (b clusterBootstrapRBAC) install(ctx context.Context, cluster *application.Cluster) error {
+	clientset, err := b.clientset(cluster)
+	if err != nil {
+		return err
+	}
+
+	objects := clusterRBACObjects(clientset, b.namespace, cluster.Namespaces)
+	managed := make([]bool, len(objects))
+
+	for i, o := range objects {
+		if managed[i], err = o.managed(ctx, true); err != nil {
+			return err
+		}
+	}
+
+	token, err := clusterauth.InstallClusterManagerRBAC(clientset, b.namespace, cluster.Namespaces, clusterBootstrapTokenTimeout)
+
+	// Updating existing roles and bindings drops their labels, which are then
+	// set again even if the installation failed part way through
+	for i, o := range objects {
+		if !managed[i] {
+			continue
+		}
+
+		if lerr := o.label(ctx); lerr != nil && !apierrors.IsNotFound(lerr) && err == nil {
+			err = lerr
+		}
+	}
+
+	if err != nil {
+		return err
+	}
+
+	cluster.Config = application.ClusterConfig{
+		BearerToken: token,
+		TLSClientConfig: application.TLSClientConfig{
+			Insecure:   cluster.Config.Insecure,
+			ServerName: cluster.Config.ServerName,
+			CAData:     cluster.Config.CAData,
+		},
+	}
 
 	return nil
 }
 
-func getClusterQueryFromID(d *schema.ResourceData) *clusterClient.ClusterQuery {
-	cq := &clusterClient.ClusterQuery{}
+// uninstall removes the ServiceAccount and RBAC created by install for the
+// given namespaces.
+func (b clusterBootstrapRBAC) uninstall(ctx context.Context, cluster *application.Cluster, namespaces []string) error {
+	clientset, err := b.clientset(cluster)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range clusterRBACObjects(clientset, b.namespace, namespaces) {
+		if err = o.delete(ctx); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// pruneClusterRBAC removes the roles granted by install for the previous
+// namespaces that are not needed anymore for the current ones. The cluster-wide
+// role is handled when switching from all namespaces to a restricted list.
+func pruneClusterRBAC(ctx context.Context, clientset kubernetes.Interface, previous, current []string) error {
+	var objects []clusterRBACObject
+
+	if len(previous) == 0 {
+		if len(current) == 0 {
+			return nil
+		}
 
-	id := strings.Split(strings.TrimPrefix(d.Id(), "https://"), "/")
-	if len(id) > 1 {
-		cq.Name = id[len(id)-1]
-		cq.Server = fmt.Sprintf("https://%s", strings.Join(id[:len(id)-1], "/"))
+		objects = clusterRBACRoleObjects(clientset, nil)
 	} else {
-		cq.Server = d.Id()
+		var removed []string
+
+		for _, ns := range previous {
+			if !slices.Contains(current, ns) {
+				removed = append(removed, ns)
+			}
+		}
+
+		if len(removed) == 0 {
+			return nil
+		}
+
+		objects = clusterRBACRoleObjects(clientset, removed)
 	}
 
-	return cq
+	for _, o := range objects {
+		if err := o.delete(ctx); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+const (
+	clusterRBACManagedByLabel = "app.kubernetes.io/managed-by"
+	clusterRBACManagedBy      = "terraform-provider-argocd"
+)
+
+// clusterRBACObject is one of the objects created in the target cluster by
+// install.
+type clusterRBACObject struct {
+	kind   string
+	name   string
+	get    func(ctx context.Context) (metav1.Object, error)
+	patch  func(ctx context.Context, data []byte) error
+	remove func(ctx context.Context) error
+}
+
+type clusterRBACClient[T metav1.Object] interface {
+	Get(ctx context.Context, name string, opts metav1.GetOptions) (T, error)
+	Patch(ctx context.Context, name string, pt k8stypes.PatchType, data []byte, opts metav1.PatchOptions, subresources ...string) (T, error)
+	Delete(ctx context.Context, name string, opts metav1.DeleteOptions) error
+}
+
+func newClusterRBACObject[T metav1.Object](c clusterRBACClient[T], kind, namespace, name string) clusterRBACObject {
+	if namespace != "" {
+		kind = fmt.Sprintf("%s in namespace %s", kind, namespace)
+	}
+
+	return clusterRBACObject{
+		kind: kind,
+		name: name,
+		get: func(ctx context.Context) (metav1.Object, error) {
+			return c.Get(ctx, name, metav1.GetOptions{})
+		},
+		patch: func(ctx context.Context, data []byte) error {
+			_, err := c.Patch(ctx, name, k8stypes.MergePatchType, data, metav1.PatchOptions{})
+			return err
+		},
+		remove: func(ctx context.Context) error {
+			return c.Delete(ctx, name, metav1.DeleteOptions{})
+		},
+	}
+}
+
+// clusterRBACObjects returns the objects created by install, in the order in
+// which they are to be deleted.
+func clusterRBACObjects(clientset kubernetes.Interface, namespace string, namespaces []string) []clusterRBACObject {
+	return append(
+		clusterRBACRoleObjects(clientset, namespaces),
+		newClusterRBACObject(clientset.CoreV1().ServiceAccounts(namespace), "ServiceAccount", namespace, clusterauth.ArgoCDManagerServiceAccount),
+	)
+}
+
+// clusterRBACRoleObjects returns the roles and bindings created by install,
+// either cluster-wide or for each of the given namespaces.
+func clusterRBACRoleObjects(clientset kubernetes.Interface, namespaces []string) []clusterRBACObject {
+	if len(namespaces) == 0 {
+		return []clusterRBACObject{
+			newClusterRBACObject(clientset.RbacV1().ClusterRoleBindings(), "ClusterRoleBinding", "", clusterauth.ArgoCDManagerClusterRoleBinding),
+			newClusterRBACObject(clientset.RbacV1().ClusterRoles(), "ClusterRole", "", clusterauth.ArgoCDManagerClusterRole),
+		}
+	}
+
+	objects := make([]clusterRBACObject, 0, 2*len(namespaces))
+	for _, ns := range namespaces {
+		objects = append(objects,
+			newClusterRBACObject(clientset.RbacV1().RoleBindings(ns), "RoleBinding", ns, clusterauth.ArgoCDManagerClusterRoleBinding),
+			newClusterRBACObject(clientset.RbacV1().Roles(ns), "Role", ns, clusterauth.ArgoCDManagerClusterRole),
+		)
+	}
+
+	return objects
+}
+
+// managed returns whether the object is labelled as managed by the provider,
+// or is missing if missing is true.
+func (o clusterRBACObject) managed(ctx context.Context, missing bool) (bool, error) {
+	obj, err := o.get(ctx)
+	if apierrors.IsNotFound(err) {
+		return missing, nil
+	} else if err != nil {
+		return false, fmt.Errorf("failed to get %s %s: %w", o.kind, o.name, err)
+	}
+
+	return obj.GetLabels()[clusterRBACManagedByLabel] == clusterRBACManagedBy, nil
+}
+
+// label marks the object as managed by the provider.
+func (o clusterRBACObject) label(ctx context.Context) error {
+	data := fmt.Sprintf(`{"metadata":{"labels":{%q:%q}}}`, clusterRBACManagedByLabel, clusterRBACManagedBy)
+	if err := o.patch(ctx, []byte(data)); err != nil {
+		return fmt.Errorf("failed to label %s %s: %w", o.kind, o.name, err)
+	}
+
+	return nil
+}
+
+// delete removes the object, unless it is not managed by the provider.
+func (o clusterRBACObject) delete(ctx context.Context) error {
+	managed, err := o.managed(ctx, false)
+	if err != nil || !managed {
+		return err
+	}
+
+	if err = o.remove(ctx); err != nil && !apierrors.IsNotFound(err) {
+		return fmt.Errorf("failed to delete %s %s: %w", o.kind, o.name, err)
+	}
+
+	return nil
 }
@@ -13,12 +13,23 @@ import (
 	"github.com/argoproj-labs/terraform-provider-argocd/internal/provider"
 	"github.com/argoproj-labs/terraform-provider-argocd/internal/testhelpers"
 	"github.com/argoproj/argo-cd/v3/pkg/apiclient/cluster"
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/util/clusterauth"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/fake"
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
 	"k8s.io/client-go/util/homedir"
 )
 
@@ -108,6 +119,11 @@ func TestAccArgoCDCluster_projectScope(t *testing.T) {
 						"project",
 						"myproject1",
 					),
+					resource.TestCheckResourceAttr(
+						"argocd_cluster.project_scope",
+						"project_scoped",
+						"true",
+					),
 				),
 			},
 			{
@@ -120,6 +136,90 @@ func TestAccArgoCDCluster_projectScope(t *testing.T) {
 	})
 }
 
+func TestAccArgoCDCluster_projectNotFound(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccArgoCDClusterProjectScope(acctest.RandString(10), acctest.RandomWithPrefix("missing")),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("project .* does not exist"),
+			},
+		},
+	})
+}
+
+func TestAccArgoCDCluster_projectDestinationMismatch(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				// A destination mismatch is only a warning and does not prevent the cluster from being created
+				Config: testAccArgoCDClusterProjectScope(acctest.RandString(10), "myproject2"),
+				Check: resource.TestCheckResourceAttr(
+					"argocd_cluster.project_scope",
+					"project",
+					"myproject2",
+				),
+			},
+		},
+	})
+}
+
+func TestProjectDestinationsAllowCluster(t *testing.T) {
+	t.Parallel()
+
+	c := &v1alpha1.Cluster{Server: "https://prod.example.com/", Name: "prod"}
+
+	tests := []struct {
+		name         string
+		destinations []v1alpha1.ApplicationDestination
+		allowed      bool
+	}{
+		{
+			name:    "no destinations",
+			allowed: false,
+		},
+		{
+			name:         "server",
+			destinations: []v1alpha1.ApplicationDestination{{Server: "https://prod.example.com", Namespace: "foo"}},
+			allowed:      true,
+		},
+		{
+			name:         "server pattern",
+			destinations: []v1alpha1.ApplicationDestination{{Server: "https://*.example.com", Namespace: "*"}},
+			allowed:      true,
+		},
+		{
+			name:         "name",
+			destinations: []v1alpha1.ApplicationDestination{{Name: "prod", Namespace: "*"}},
+			allowed:      true,
+		},
+		{
+			name:         "other cluster",
+			destinations: []v1alpha1.ApplicationDestination{{Server: "https://dev.example.com", Namespace: "*"}, {Name: "dev", Namespace: "*"}},
+			allowed:      false,
+		},
+		{
+			name:         "deny pattern",
+			destinations: []v1alpha1.ApplicationDestination{{Server: "!https://dev.example.com", Namespace: "*"}},
+			allowed:      false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if allowed := projectDestinationsAllowCluster(tt.destinations, c); allowed != tt.allowed {
+				t.Errorf("expected %t, got %t", tt.allowed, allowed)
+			}
+		})
+	}
+}
+
 func TestAccArgoCDCluster_optionalName(t *testing.T) {
 	name := acctest.RandString(10)
 
@@ -278,6 +378,471 @@ func TestAccArgoCDCluster_metadata(t *testing.T) {
 	})
 }
 
+func TestAccArgoCDCluster_adoptExisting(t *testing.T) {
+	clusterName := acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() {
+					si, err := getServerInterface()
+					if err != nil {
+						t.Fatalf("failed to get server interface: %s", err.Error())
+					}
+
+					ctx, cancel := context.WithTimeout(t.Context(), 120*time.Second)
+					defer cancel()
+
+					_, err = si.ClusterClient.Create(ctx, &cluster.ClusterCreateRequest{
+						Cluster: &v1alpha1.Cluster{
+							Server: "https://kubernetes.default.svc.cluster.local",
+							Name:   "bootstrapped",
+							Config: getClusterConfig(),
+						},
+					})
+					if err != nil {
+						t.Fatalf("failed to create cluster: %s", err.Error())
+					}
+				},
+				Config:      testAccArgoCDClusterAdoptExisting(clusterName, false),
+				ExpectError: regexp.MustCompile("cluster with server address .* already exists"),
+			},
+			{
+				Config: testAccArgoCDClusterAdoptExisting(clusterName, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"argocd_cluster.adopt_existing",
+						"name",
+						clusterName,
+					),
+					resource.TestCheckResourceAttr(
+						"argocd_cluster.adopt_existing",
+						"adopt_existing",
+						"true",
+					),
+				),
+			},
+		},
+	})
+}
+
+func TestAccArgoCDCluster_kubeconfig(t *testing.T) {
+	clusterName := acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccArgoCDClusterKubeconfig(t, clusterName, "https://kubernetes.default.svc.cluster.local"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"argocd_cluster.kubeconfig",
+						"server",
+						"https://kubernetes.default.svc.cluster.local",
+					),
+					resource.TestCheckResourceAttr(
+						"argocd_cluster.kubeconfig",
+						"info.0.connection_state.0.status",
+						"Successful",
+					),
+					resource.TestCheckNoResourceAttr(
+						"argocd_cluster.kubeconfig",
+						"config.#",
+					),
+				),
+			},
+			{
+				Config:   testAccArgoCDClusterKubeconfig(t, clusterName, "https://kubernetes.default.svc.cluster.local"),
+				PlanOnly: true,
+			},
+			{
+				// Pointing the kubeconfig to another server replaces the cluster
+				Config: testAccArgoCDClusterKubeconfig(t, clusterName, "https://kubernetes.default"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("argocd_cluster.kubeconfig", plancheck.ResourceActionReplace),
+					},
+				},
+				Check: resource.TestCheckResourceAttr(
+					"argocd_cluster.kubeconfig",
+					"server",
+					"https://kubernetes.default",
+				),
+			},
+		},
+	})
+}
+
+func TestAccArgoCDCluster_bootstrapRBAC(t *testing.T) {
+	clusterName := acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckArgoCDClusterBootstrapRBACDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccArgoCDClusterBootstrapRBAC(t, clusterName, ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"argocd_cluster.bootstrap",
+						"info.0.connection_state.0.status",
+						"Successful",
+					),
+					resource.TestCheckResourceAttr(
+						"argocd_cluster.bootstrap",
+						"bootstrap_rbac.0.namespace",
+						"kube-system",
+					),
+					testAccCheckArgoCDClusterBootstrapRBAC(func(clientset kubernetes.Interface) error {
+						_, err := clientset.RbacV1().ClusterRoleBindings().Get(context.Background(), clusterauth.ArgoCDManagerClusterRoleBinding, metav1.GetOptions{})
+						return err
+					}),
+				),
+			},
+			{
+				Config:   testAccArgoCDClusterBootstrapRBAC(t, clusterName, ""),
+				PlanOnly: true,
+			},
+			{
+				Config: testAccArgoCDClusterBootstrapRBAC(t, clusterName, `namespaces = ["default"]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"argocd_cluster.bootstrap",
+						"namespaces.0",
+						"default",
+					),
+					testAccCheckArgoCDClusterBootstrapRBAC(func(clientset kubernetes.Interface) error {
+						if _, err := clientset.RbacV1().RoleBindings("default").Get(context.Background(), clusterauth.ArgoCDManagerClusterRoleBinding, metav1.GetOptions{}); err != nil {
+							return err
+						}
+
+						if _, err := clientset.RbacV1().ClusterRoleBindings().Get(context.Background(), clusterauth.ArgoCDManagerClusterRoleBinding, metav1.GetOptions{}); !apierrors.IsNotFound(err) {
+							return fmt.Errorf("expected ClusterRoleBinding to be removed, got: %v", err)
+						}
+
+						return nil
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestPruneClusterRBAC(t *testing.T) {
+	t.Parallel()
+
+	managed := map[string]string{clusterRBACManagedByLabel: clusterRBACManagedBy}
+	roleBinding := func(namespace string, labels map[string]string) *rbacv1.RoleBinding {
+		return &rbacv1.RoleBinding{ObjectMeta: metav1.ObjectMeta{Name: clusterauth.ArgoCDManagerClusterRoleBinding, Namespace: namespace, Labels: labels}}
+	}
+
+	clientset := fake.NewClientset(
+		&rbacv1.ClusterRoleBinding{ObjectMeta: metav1.ObjectMeta{Name: clusterauth.ArgoCDManagerClusterRoleBinding, Labels: managed}},
+		&rbacv1.ClusterRole{ObjectMeta: metav1.ObjectMeta{Name: clusterauth.ArgoCDManagerClusterRole, Labels: managed}},
+		roleBinding("foo", managed),
+		roleBinding("bar", managed),
+		roleBinding("baz", nil),
+	)
+
+	ctx := t.Context()
+
+	// Nothing to prune while access is not restricted
+	if err := pruneClusterRBAC(ctx, clientset, nil, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := clientset.RbacV1().ClusterRoleBindings().Get(ctx, clusterauth.ArgoCDManagerClusterRoleBinding, metav1.GetOptions{}); err != nil {
+		t.Fatalf("expected ClusterRoleBinding to be kept, got: %v", err)
+	}
+
+	// Restricting access to namespaces removes the cluster-wide role
+	if err := pruneClusterRBAC(ctx, clientset, nil, []string{"foo", "bar"}); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := clientset.RbacV1().ClusterRoleBindings().Get(ctx, clusterauth.ArgoCDManagerClusterRoleBinding, metav1.GetOptions{}); !apierrors.IsNotFound(err) {
+		t.Fatalf("expected ClusterRoleBinding to be removed, got: %v", err)
+	}
+
+	// Only the roles of namespaces that are not listed anymore are removed
+	if err := pruneClusterRBAC(ctx, clientset, []string{"foo", "bar", "baz"}, []string{"foo"}); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := clientset.RbacV1().RoleBindings("foo").Get(ctx, clusterauth.ArgoCDManagerClusterRoleBinding, metav1.GetOptions{}); err != nil {
+		t.Fatalf("expected RoleBinding in namespace foo to be kept, got: %v", err)
+	}
+
+	if _, err := clientset.RbacV1().RoleBindings("bar").Get(ctx, clusterauth.ArgoCDManagerClusterRoleBinding, metav1.GetOptions{}); !apierrors.IsNotFound(err) {
+		t.Fatalf("expected RoleBinding in namespace bar to be removed, got: %v", err)
+	}
+
+	// Objects not created by the provider are left alone
+	if _, err := clientset.RbacV1().RoleBindings("baz").Get(ctx, clusterauth.ArgoCDManagerClusterRoleBinding, metav1.GetOptions{}); err != nil {
+		t.Fatalf("expected unmanaged RoleBinding in namespace baz to be kept, got: %v", err)
+	}
+}
+
+func TestClusterRBACObjectLabel(t *testing.T) {
+	t.Parallel()
+
+	clientset := fake.NewClientset(
+		&corev1.ServiceAccount{ObjectMeta: metav1.ObjectMeta{Name: clusterauth.ArgoCDManagerServiceAccount, Namespace: "kube-system"}},
+	)
+
+	ctx := t.Context()
+	objects := clusterRBACObjects(clientset, "kube-system", nil)
+	sa := objects[len(objects)-1]
+
+	// A missing object is considered managed only before being created
+	managed, err := objects[0].managed(ctx, true)
+	if err != nil || !managed {
+		t.Fatalf("expected missing ClusterRoleBinding to be managed, got: %t, %v", managed, err)
+	}
+
+	// A pre-existing ServiceAccount is neither managed nor deleted
+	if managed, err = sa.managed(ctx, true); err != nil || managed {
+		t.Fatalf("expected existing ServiceAccount not to be managed, got: %t, %v", managed, err)
+	}
+
+	if err = sa.delete(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err = clientset.CoreV1().ServiceAccounts("kube-system").Get(ctx, clusterauth.ArgoCDManagerServiceAccount, metav1.GetOptions{}); err != nil {
+		t.Fatalf("expected unmanaged ServiceAccount to be kept, got: %v", err)
+	}
+
+	// Once labelled, it is deleted
+	if err = sa.label(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = sa.delete(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err = clientset.CoreV1().ServiceAccounts("kube-system").Get(ctx, clusterauth.ArgoCDManagerServiceAccount, metav1.GetOptions{}); !apierrors.IsNotFound(err) {
+		t.Fatalf("expected managed ServiceAccount to be removed, got: %v", err)
+	}
+}
+
+func TestAccArgoCDCluster_rotationTriggers(t *testing.T) {
+	clusterName := acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccArgoCDClusterBootstrapRBAC(t, clusterName, `rotation_triggers = { rotation = "1" }`),
+				Check: resource.TestCheckResourceAttr(
+					"argocd_cluster.bootstrap",
+					"info.0.connection_state.0.status",
+					"Successful",
+				),
+			},
+			{
+				Config: testAccArgoCDClusterBootstrapRBAC(t, clusterName, `rotation_triggers = { rotation = "2" }`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"argocd_cluster.bootstrap",
+						"rotation_triggers.rotation",
+						"2",
+					),
+					resource.TestCheckResourceAttr(
+						"argocd_cluster.bootstrap",
+						"info.0.connection_state.0.status",
+						"Successful",
+					),
+				),
+			},
+			{
+				// Updating the cluster must not revert the rotated token
+				Config: testAccArgoCDClusterBootstrapRBAC(t, clusterName, `
+  rotation_triggers = { rotation = "2" }
+  metadata {
+    labels = {
+      test = "rotation"
+    }
+  }`),
+				Check: resource.TestCheckResourceAttr(
+					"argocd_cluster.bootstrap",
+					"info.0.connection_state.0.status",
+					"Successful",
+				),
+			},
+			{
+				// Removing the triggers neither rotates nor reverts the rotated token
+				Config: testAccArgoCDClusterBootstrapRBAC(t, clusterName, ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckNoResourceAttr(
+						"argocd_cluster.bootstrap",
+						"rotation_triggers.rotation",
+					),
+					resource.TestCheckResourceAttr(
+						"argocd_cluster.bootstrap",
+						"info.0.connection_state.0.status",
+						"Successful",
+					),
+				),
+			},
+		},
+	})
+}
+
+func TestClusterRotationTriggered(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		o, n    map[string]interface{}
+		trigger bool
+	}{
+		{
+			name:    "no triggers",
+			trigger: false,
+		},
+		{
+			name:    "added",
+			n:       map[string]interface{}{"rotation": "1"},
+			trigger: true,
+		},
+		{
+			name:    "changed",
+			o:       map[string]interface{}{"rotation": "1"},
+			n:       map[string]interface{}{"rotation": "2"},
+			trigger: true,
+		},
+		{
+			name:    "unchanged",
+			o:       map[string]interface{}{"rotation": "1"},
+			n:       map[string]interface{}{"rotation": "1"},
+			trigger: false,
+		},
+		{
+			name:    "removed after rotation",
+			o:       map[string]interface{}{"rotation": "2"},
+			n:       map[string]interface{}{},
+			trigger: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.trigger, clusterRotationTriggered(tt.o, tt.n))
+		})
+	}
+}
+
+func TestAccArgoCDCluster_verifyConnection(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccArgoCDClusterVerifyConnection(acctest.RandString(10), "https://kubernetes.default.svc.cluster.local"),
+				Check: resource.TestCheckResourceAttr(
+					"argocd_cluster.verify",
+					"info.0.connection_state.0.status",
+					"Successful",
+				),
+			},
+			{
+				Config:      testAccArgoCDClusterVerifyConnection(acctest.RandString(10), "https://127.0.0.1:1"),
+				ExpectError: regexp.MustCompile("failed to verify connection to cluster"),
+			},
+		},
+	})
+}
+
+func TestAccArgoCDCluster_shardCount(t *testing.T) {
+	clusterName := acctest.RandString(10)
+	shard := convertInt64ToString(clusterShard("https://kubernetes.default.svc.cluster.local", 4))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccArgoCDClusterShardCount(clusterName, ""),
+				Check: resource.TestCheckResourceAttr(
+					"argocd_cluster.sharded",
+					"shard",
+					shard,
+				),
+			},
+			{
+				Config:   testAccArgoCDClusterShardCount(clusterName, ""),
+				PlanOnly: true,
+			},
+			{
+				// An explicit shard takes precedence over the assigned one
+				Config: testAccArgoCDClusterShardCount(clusterName, `shard = "7"`),
+				Check: resource.TestCheckResourceAttr(
+					"argocd_cluster.sharded",
+					"shard",
+					"7",
+				),
+			},
+		},
+	})
+}
+
+func TestClusterShard(t *testing.T) {
+	t.Parallel()
+
+	servers := make([]string, 1000)
+	for i := range servers {
+		servers[i] = fmt.Sprintf("https://cluster-%d.example.com", i)
+	}
+
+	counts := make(map[int64]int)
+
+	for _, server := range servers {
+		shard := clusterShard(server, 3)
+		if shard < 0 || shard >= 3 {
+			t.Fatalf("shard %d of %s out of range", shard, server)
+		}
+
+		if clusterShard(server+"/", 3) != shard {
+			t.Fatalf("shard of %s depends on trailing slash", server)
+		}
+
+		counts[shard]++
+
+		// Adding a shard only moves clusters to the new shard
+		if s := clusterShard(server, 4); s != shard && s != 3 {
+			t.Fatalf("cluster %s moved from shard %d to %d", server, shard, s)
+		}
+	}
+
+	for shard, count := range counts {
+		if count < 250 {
+			t.Errorf("shard %d only has %d clusters", shard, count)
+		}
+	}
+}
+
+func TestClusterUpdatedFields(t *testing.T) {
+	t.Parallel()
+
+	// Every field ArgoCD accepts in ClusterUpdateRequest.UpdatedFields is managed
+	// by the resource
+	assert.ElementsMatch(t,
+		[]string{"name", "namespaces", "shard", "clusterResources", "labels", "annotations", "project", "config"},
+		clusterUpdatedFields(true),
+	)
+	assert.NotContains(t, clusterUpdatedFields(false), "config")
+	assert.Contains(t, clusterUpdatedFields(false), "clusterResources")
+}
+
 func TestAccArgoCDCluster_invalidSameServer(t *testing.T) {
 	resource.Test(t, resource.TestCase{
 		PreCheck:          func() { testAccPreCheck(t) },
@@ -452,6 +1017,43 @@ resource "argocd_cluster" "simple" {
 `, clusterName, getConfig())
 }
 
+func testAccArgoCDClusterVerifyConnection(clusterName, server string) string {
+	return fmt.Sprintf(`
+resource "argocd_cluster" "verify" {
+  server            = "%s"
+  name              = "%s"
+  verify_connection = true
+
+  config {
+%s
+  }
+
+  timeouts {
+    create = "1m"
+    update = "1m"
+  }
+}
+`, server, clusterName, getConfig())
+}
+
+func testAccArgoCDClusterShardCount(clusterName, shard string) string {
+	return fmt.Sprintf(`
+provider "argocd" {
+  shard_count = 4
+}
+
+resource "argocd_cluster" "sharded" {
+  server = "https://kubernetes.default.svc.cluster.local"
+  name   = "%s"
+  %s
+
+  config {
+%s
+  }
+}
+`, clusterName, shard, getConfig())
+}
+
 func testAccArgoCDClusterTLSCertificate(t *testing.T, clusterName string) string {
 	// Skip if we're not in an acceptance test environment
 	if os.Getenv("TF_ACC") == "" {
@@ -523,6 +1125,126 @@ resource "argocd_cluster" "cluster_metadata" {
 `, getConfig())
 }
 
+func testAccArgoCDClusterAdoptExisting(clusterName string, adopt bool) string {
+	return fmt.Sprintf(`
+resource "argocd_cluster" "adopt_existing" {
+  server = "https://kubernetes.default.svc.cluster.local"
+  name   = "%s"
+  config {
+%s
+  }
+  adopt_existing = %t
+}
+`, clusterName, getConfig(), adopt)
+}
+
+func testAccArgoCDClusterKubeconfig(t *testing.T, clusterName, server string) string {
+	c := getClusterConfig()
+
+	kc := clientcmdapi.NewConfig()
+	kc.Clusters["argocd"] = &clientcmdapi.Cluster{
+		Server:                   server,
+		CertificateAuthorityData: c.CAData,
+		InsecureSkipTLSVerify:    c.Insecure,
+	}
+	kc.AuthInfos["argocd"] = &clientcmdapi.AuthInfo{
+		Token:                 c.BearerToken,
+		ClientCertificateData: c.CertData,
+		ClientKeyData:         c.KeyData,
+	}
+	kc.Contexts["argocd"] = &clientcmdapi.Context{
+		Cluster:  "argocd",
+		AuthInfo: "argocd",
+	}
+
+	raw, err := clientcmd.Write(*kc)
+	if err != nil {
+		t.Fatalf("failed to write kubeconfig: %s", err.Error())
+	}
+
+	return fmt.Sprintf(`
+resource "argocd_cluster" "kubeconfig" {
+  name = "%s"
+
+  kubeconfig {
+    raw     = <<EOT
+%sEOT
+    context = "argocd"
+  }
+}
+`, clusterName, raw)
+}
+
+func testAccArgoCDClusterBootstrapRBAC(t *testing.T, clusterName, extra string) string {
+	// Skip if we're not in an acceptance test environment
+	if os.Getenv("TF_ACC") == "" {
+		t.Skip("Acceptance tests skipped unless env 'TF_ACC' set")
+	}
+
+	rc, err := getInternalRestConfig()
+	if err != nil {
+		t.Error(err)
+	}
+
+	return fmt.Sprintf(`
+resource "argocd_cluster" "bootstrap" {
+  server = "https://kubernetes.default.svc.cluster.local"
+  name   = "%s"
+  %s
+
+  config {
+    tls_client_config {
+      key_data  = <<EOT
+%s
+EOT
+      cert_data = <<EOT
+%s
+EOT
+      ca_data   = <<EOT
+%s
+EOT
+    }
+  }
+
+  bootstrap_rbac {
+    server = "%s"
+  }
+}
+`, clusterName, extra, rc.KeyData, rc.CertData, rc.CAData, rc.Host)
+}
+
+func testAccCheckArgoCDClusterBootstrapRBAC(check func(clientset kubernetes.Interface) error) resource.TestCheckFunc {
+	return func(_ *terraform.State) error {
+		rc, err := getInternalRestConfig()
+		if err != nil {
+			return err
+		}
+
+		clientset, err := kubernetes.NewForConfig(rc)
+		if err != nil {
+			return err
+		}
+
+		return check(clientset)
+	}
+}
+
+func testAccCheckArgoCDClusterBootstrapRBACDestroyed(s *terraform.State) error {
+	return testAccCheckArgoCDClusterBootstrapRBAC(func(clientset kubernetes.Interface) error {
+		_, err := clientset.CoreV1().ServiceAccounts("kube-system").Get(context.Background(), clusterauth.ArgoCDManagerServiceAccount, metav1.GetOptions{})
+		if !apierrors.IsNotFound(err) {
+			return fmt.Errorf("expected ServiceAccount to be removed, got: %v", err)
+		}
+
+		_, err = clientset.RbacV1().RoleBindings("default").Get(context.Background(), clusterauth.ArgoCDManagerClusterRoleBinding, metav1.GetOptions{})
+		if !apierrors.IsNotFound(err) {
+			return fmt.Errorf("expected RoleBinding to be removed, got: %v", err)
+		}
+
+		return nil
+	})(s)
+}
+
 func testAccArgoCDClusterTwiceWithSameServer() string {
 	return fmt.Sprintf(`
 resource "argocd_cluster" "cluster_one_same_server" {
@@ -757,6 +1479,29 @@ func getConfig() string {
 `
 }
 
+// getClusterConfig returns the API equivalent of the configuration rendered by
+// getConfig.
+func getClusterConfig() v1alpha1.ClusterConfig {
+	if testhelpers.GlobalTestEnv != nil {
+		r := testhelpers.GlobalTestEnv.RESTConfig
+
+		return v1alpha1.ClusterConfig{
+			TLSClientConfig: v1alpha1.TLSClientConfig{
+				CAData:   r.CAData,
+				CertData: r.CertData,
+				KeyData:  r.KeyData,
+			},
+		}
+	}
+
+	return v1alpha1.ClusterConfig{
+		BearerToken: "abcdef.0123456789abcdef",
+		TLSClientConfig: v1alpha1.TLSClientConfig{
+			Insecure: true,
+		},
+	}
+}
+
 func isInsecure() bool {
 	return testhelpers.GlobalTestEnv == nil
 }
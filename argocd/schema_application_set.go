@@ -51,7 +51,7 @@ func applicationSetSpecSchemaV0() *schema.Schema {
 				"generator": applicationSetGeneratorSchemaV0(),
 				"go_template": {
 					Type:        schema.TypeBool,
-					Description: "Enable use of [Go Text Template](https://pkg.go.dev/text/template).",
+					Description: "Enable use of [Go Text Template](https://pkg.go.dev/text/template). Templated strings within `template`, `template_patch` and the generator fields rendered by the ApplicationSet controller are parsed at plan time. List `elements` and plugin `input` parameters are not parsed.",
 					Optional:    true,
 				},
 				"go_template_options": {
@@ -542,6 +542,50 @@ func applicationSetSCMProviderGeneratorSchemaV0() *schema.Schema {
 		Optional:    true,
 		Elem: &schema.Resource{
 			Schema: map[string]*schema.Schema{
+				"aws_code_commit": {
+					Type:        schema.TypeList,
+					Description: "Uses the AWS ResourceGroupsTagging and AWS CodeCommit APIs to scan repos across AWS accounts and regions.",
+					Optional:    true,
+					MaxItems:    1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"all_branches": {
+								Type:        schema.TypeBool,
+								Description: "Scan all branches instead of just the default branch.",
+								Optional:    true,
+							},
+							"region": {
+								Type:        schema.TypeString,
+								Description: "AWS region to scan repos. Defaults to the region of the ApplicationSet controller.",
+								Optional:    true,
+							},
+							"role": {
+								Type:        schema.TypeString,
+								Description: "AWS IAM role to assume for cross-account repo discovery. If not provided, the ApplicationSet controller will use its pod/node identity.",
+								Optional:    true,
+							},
+							"tag_filter": {
+								Type:        schema.TypeList,
+								Description: "Filters repositories by AWS resource tags. If no value is specified, all repositories with the given tag key are matched.",
+								Optional:    true,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"key": {
+											Type:        schema.TypeString,
+											Description: "Tag key.",
+											Required:    true,
+										},
+										"value": {
+											Type:        schema.TypeString,
+											Description: "Tag value.",
+											Optional:    true,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
 				"azure_devops": {
 					Type:        schema.TypeList,
 					Description: "Uses the Azure DevOps API to look up eligible repositories based on a team project within an Azure DevOps organization.",
@@ -834,6 +878,70 @@ func applicationSetPullRequestGeneratorSchemaV0() *schema.Schema {
 		Optional:    true,
 		Elem: &schema.Resource{
 			Schema: map[string]*schema.Schema{
+				"bitbucket_cloud": {
+					Type:        schema.TypeList,
+					Description: "Fetch pull requests from a repo hosted on Bitbucket Cloud.",
+					Optional:    true,
+					MaxItems:    1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"api": {
+								Type:        schema.TypeString,
+								Description: "The Bitbucket REST API URL to talk to. If blank, uses https://api.bitbucket.org/2.0.",
+								Optional:    true,
+							},
+							"basic_auth": {
+								Type:        schema.TypeList,
+								Description: "Credentials for Basic auth.",
+								Optional:    true,
+								MaxItems:    1,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"username": {
+											Type:        schema.TypeString,
+											Description: "Username for Basic auth.",
+											Optional:    true,
+										},
+										"password_ref": {
+											Type:        schema.TypeList,
+											Description: "Password (or app password) reference.",
+											Optional:    true,
+											MaxItems:    1,
+											Elem:        secretRefResource(),
+										},
+									},
+								},
+							},
+							"bearer_token": {
+								Type:        schema.TypeList,
+								Description: "Credentials for App Token (Bearer auth).",
+								Optional:    true,
+								MaxItems:    1,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"token_ref": {
+											Type:        schema.TypeList,
+											Description: "App token reference.",
+											Required:    true,
+											MaxItems:    1,
+											Elem:        secretRefResource(),
+										},
+									},
+								},
+							},
+							"owner": {
+								Type:        schema.TypeString,
+								Description: "Workspace to scan.",
+								Required:    true,
+							},
+							"repo": {
+								Type:        schema.TypeString,
+								Description: "Repo name to scan.",
+								Required:    true,
+							},
+						},
+					},
+				},
 				"bitbucket_server": {
 					Type:        schema.TypeList,
 					Description: "Fetch pull requests from a repo hosted on a Bitbucket Server.",
@@ -924,6 +1032,11 @@ func applicationSetPullRequestGeneratorSchemaV0() *schema.Schema {
 						},
 					},
 				},
+				"continue_on_repo_not_found_error": {
+					Type:        schema.TypeBool,
+					Description: "Continue generating parameters for the other generators even if the repository is not found, instead of failing the application set.",
+					Optional:    true,
+				},
 				"filter": {
 					Type:        schema.TypeList,
 					Description: "Filters allow selecting which pull requests to generate for.",
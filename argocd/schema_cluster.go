@@ -4,6 +4,7 @@ import (
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func clusterSchema() map[string]*schema.Schema {
@@ -26,8 +27,9 @@ func clusterSchema() map[string]*schema.Schema {
 		},
 		"server": {
 			Type:        schema.TypeString,
-			Description: "Server is the API server URL of the Kubernetes cluster.",
+			Description: "Server is the API server URL of the Kubernetes cluster. Defaults to the server of the selected context when `kubeconfig` is set, in which case the cluster is replaced when that server changes.",
 			Optional:    true,
+			Computed:    true,
 			ForceNew:    true,
 			DiffSuppressFunc: func(k, oldValue, newValue string, d *schema.ResourceData) bool {
 				return oldValue == strings.TrimRight(newValue, "/")
@@ -35,8 +37,9 @@ func clusterSchema() map[string]*schema.Schema {
 		},
 		"shard": {
 			Type:        schema.TypeString,
-			Description: "Optional shard number. Calculated on the fly by the application controller if not specified.",
+			Description: "Optional shard number. Calculated on the fly by the application controller if not specified, unless `shard_count` is set in the provider configuration, in which case a shard is assigned from the server address.",
 			Optional:    true,
+			Computed:    true,
 		},
 		"namespaces": {
 			Type:        schema.TypeList,
@@ -47,11 +50,11 @@ func clusterSchema() map[string]*schema.Schema {
 			},
 		},
 		"config": {
-			Type:        schema.TypeList,
-			Description: "Cluster information for connecting to a cluster.",
-			Required:    true,
-			MinItems:    1,
-			MaxItems:    1,
+			Type:         schema.TypeList,
+			Description:  "Cluster information for connecting to a cluster.",
+			Optional:     true,
+			MaxItems:     1,
+			ExactlyOneOf: []string{"config", "kubeconfig"},
 			Elem: &schema.Resource{
 				Schema: map[string]*schema.Schema{
 					"aws_auth_config": {
@@ -72,6 +75,81 @@ func clusterSchema() map[string]*schema.Schema {
 							},
 						},
 					},
+					"azure_auth_config": {
+						Type:          schema.TypeList,
+						Description:   "Authenticate to an AKS cluster through [kubelogin](https://github.com/Azure/kubelogin) using `argocd-k8s-auth azure`. Translated into the corresponding `exec_provider_config`, which is evaluated by ArgoCD, so the credentials must be available to the ArgoCD components.",
+						Optional:      true,
+						MaxItems:      1,
+						ConflictsWith: []string{"config.0.aws_auth_config", "config.0.exec_provider_config", "config.0.gcp_auth_config"},
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"login_method": {
+									Type:         schema.TypeString,
+									Description:  "Login method used to get a token, one of `workloadidentity`, `msi`, `spn` or `azurecli`.",
+									Optional:     true,
+									Default:      "workloadidentity",
+									ValidateFunc: validation.StringInSlice([]string{"workloadidentity", "msi", "spn", "azurecli"}, false),
+								},
+								"client_id": {
+									Type:         schema.TypeString,
+									Description:  "Client ID of the Azure AD application or managed identity.",
+									Optional:     true,
+									ValidateFunc: validation.IsUUID,
+								},
+								"tenant_id": {
+									Type:         schema.TypeString,
+									Description:  "Azure AD tenant ID. Injected by the workload identity webhook if omitted when using `workloadidentity`.",
+									Optional:     true,
+									ValidateFunc: validation.IsUUID,
+								},
+								"client_secret": {
+									Type:        schema.TypeString,
+									Description: "Client secret of the service principal when using `spn`.",
+									Optional:    true,
+									Sensitive:   true,
+								},
+								"federated_token_file": {
+									Type:        schema.TypeString,
+									Description: "Path of the federated token file in the ArgoCD components when using `workloadidentity`. Injected by the workload identity webhook if omitted.",
+									Optional:    true,
+								},
+								"authority_host": {
+									Type:         schema.TypeString,
+									Description:  "Azure AD authority host when using `workloadidentity`. Injected by the workload identity webhook if omitted.",
+									Optional:     true,
+									ValidateFunc: validation.IsURLWithHTTPS,
+								},
+								"environment": {
+									Type:         schema.TypeString,
+									Description:  "Azure environment, one of `AzurePublicCloud`, `AzureChinaCloud` or `AzureUSGovernmentCloud`. Defaults to `AzurePublicCloud`.",
+									Optional:     true,
+									ValidateFunc: validation.StringInSlice([]string{"AzurePublicCloud", "AzureChinaCloud", "AzureUSGovernmentCloud"}, false),
+								},
+								"server_application_id": {
+									Type:         schema.TypeString,
+									Description:  "Application ID of the AKS AAD server. Defaults to the application ID of the AKS managed AAD server.",
+									Optional:     true,
+									ValidateFunc: validation.IsUUID,
+								},
+							},
+						},
+					},
+					"gcp_auth_config": {
+						Type:          schema.TypeList,
+						Description:   "Authenticate to a GKE cluster with the [Application Default Credentials](https://cloud.google.com/docs/authentication/application-default-credentials) of the ArgoCD components, e.g. through Workload Identity, using `argocd-k8s-auth gcp`. Translated into the corresponding `exec_provider_config`.",
+						Optional:      true,
+						MaxItems:      1,
+						ConflictsWith: []string{"config.0.aws_auth_config", "config.0.exec_provider_config", "config.0.azure_auth_config"},
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"credentials_file": {
+									Type:        schema.TypeString,
+									Description: "Path of a service account key file in the ArgoCD components, used instead of the default credentials.",
+									Optional:    true,
+								},
+							},
+						},
+					},
 					"bearer_token": {
 						Type:        schema.TypeString,
 						Description: "Server requires Bearer authentication. The client will not attempt to use refresh tokens for an OAuth2 flow.",
@@ -171,6 +249,61 @@ func clusterSchema() map[string]*schema.Schema {
 				},
 			},
 		},
+		"kubeconfig": {
+			Type:         schema.TypeList,
+			Description:  "Kubeconfig from which the cluster connection information is read, as an alternative to `config`. Client certificates, token files and exec plugins referenced by the kubeconfig are resolved when the cluster is created or updated.",
+			Optional:     true,
+			MaxItems:     1,
+			ExactlyOneOf: []string{"config", "kubeconfig"},
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"raw": {
+						Type:        schema.TypeString,
+						Description: "Raw kubeconfig document.",
+						Required:    true,
+						Sensitive:   true,
+					},
+					"context": {
+						Type:        schema.TypeString,
+						Description: "Name of the kubeconfig context to use. Defaults to the current context of the kubeconfig.",
+						Optional:    true,
+					},
+				},
+			},
+		},
+		"bootstrap_rbac": {
+			Type:        schema.TypeList,
+			Description: "Provision the `argocd-manager` ServiceAccount, its RBAC and a long-lived token Secret in the target cluster, similarly to `argocd cluster add`. The credentials of `config` or `kubeconfig` are only used by the provider to create these resources, ArgoCD is registered with the token of the ServiceAccount instead. The RBAC is restricted to `namespaces` when set. Resources created by the provider are labelled with `app.kubernetes.io/managed-by: terraform-provider-argocd` and only those are removed from the target cluster when the cluster is destroyed.",
+			Optional:    true,
+			MaxItems:    1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"namespace": {
+						Type:         schema.TypeString,
+						Description:  "Namespace in which the ServiceAccount and its token Secret are created.",
+						Optional:     true,
+						Default:      "kube-system",
+						ValidateFunc: validateMetadataName,
+					},
+					"server": {
+						Type:        schema.TypeString,
+						Description: "API server URL used by the provider to reach the target cluster, if it differs from the `server` ArgoCD connects to.",
+						Optional:    true,
+					},
+				},
+			},
+		},
+		"rotation_triggers": {
+			Type:        schema.TypeMap,
+			Description: "Arbitrary map of values that, when changed, rotates the bearer token of the cluster through ArgoCD. Requires the cluster to authenticate with the token of a ServiceAccount, e.g. when using `bootstrap_rbac` or `argocd cluster add`. Use together with a `time_rotating` resource to rotate on a schedule. Once set, `config` is only sent to ArgoCD when the connection configuration changes, to avoid overwriting the rotated token. Removing the triggers neither rotates the token nor sends `config` again.",
+			Optional:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+		},
+		"verify_connection": {
+			Type:        schema.TypeBool,
+			Description: "Upon cluster creation or update, invalidate the cluster cache of ArgoCD and wait for the connection to the cluster to be successful, when set to true. Fails with the connection message reported by ArgoCD otherwise. Wait timeouts are controlled by Terraform Create and Update resource timeouts (all default to 5 minutes).",
+			Optional:    true,
+		},
 		"info": {
 			Type:        schema.TypeList,
 			Description: "Information about cluster cache and state.",
@@ -235,7 +368,17 @@ func clusterSchema() map[string]*schema.Schema {
 		},
 		"project": {
 			Type:        schema.TypeString,
-			Description: "Reference between project and cluster that allow you automatically to be added as item inside Destinations project entity. More info: https://argo-cd.readthedocs.io/en/stable/user-guide/projects/#project-scoped-repositories-and-clusters.",
+			Description: "Reference between project and cluster that allow you automatically to be added as item inside Destinations project entity. More info: https://argo-cd.readthedocs.io/en/stable/user-guide/projects/#project-scoped-repositories-and-clusters. The project must exist at plan time, unless it is not known yet (e.g. when referencing an `argocd_project` resource). A warning is raised on apply when no destination of the project matches the server address or name of the cluster.",
+			Optional:    true,
+		},
+		"project_scoped": {
+			Type:        schema.TypeBool,
+			Description: "Whether the cluster is scoped to a project.",
+			Computed:    true,
+		},
+		"adopt_existing": {
+			Type:        schema.TypeBool,
+			Description: "Whether to take over a pre-existing cluster with the same server address on creation instead of failing. The existing cluster is overwritten with the configuration.",
 			Optional:    true,
 		},
 	}
@@ -490,6 +490,8 @@ func expandApplicationSetPullRequestGeneratorGenerator(mg interface{}, featureMu
 
 	if v, ok := m["azure_devops"].([]interface{}); ok && len(v) > 0 {
 		asg.PullRequest.AzureDevOps = expandApplicationSetPullRequestGeneratorAzureDevOps(v[0].(map[string]interface{}))
+	} else if v, ok := m["bitbucket_cloud"].([]interface{}); ok && len(v) > 0 {
+		asg.PullRequest.Bitbucket = expandApplicationSetPullRequestGeneratorBitbucket(v[0].(map[string]interface{}))
 	} else if v, ok := m["bitbucket_server"].([]interface{}); ok && len(v) > 0 {
 		asg.PullRequest.BitbucketServer = expandApplicationSetPullRequestGeneratorBitbucketServer(v[0].(map[string]interface{}))
 	} else if v, ok := m["gitea"].([]interface{}); ok && len(v) > 0 {
@@ -500,6 +502,10 @@ func expandApplicationSetPullRequestGeneratorGenerator(mg interface{}, featureMu
 		asg.PullRequest.GitLab = expandApplicationSetPullRequestGeneratorGitlab(v[0].(map[string]interface{}))
 	}
 
+	if v, ok := m["continue_on_repo_not_found_error"].(bool); ok {
+		asg.PullRequest.ContinueOnRepoNotFoundError = v
+	}
+
 	if v, ok := m["filter"].([]interface{}); ok && len(v) > 0 {
 		asg.PullRequest.Filters = expandApplicationSetPullRequestGeneratorFilters(v)
 	}
@@ -529,6 +535,38 @@ func expandApplicationSetPullRequestGeneratorGenerator(mg interface{}, featureMu
 	return asg, nil
 }
 
+func expandApplicationSetPullRequestGeneratorBitbucket(b map[string]interface{}) *application.PullRequestGeneratorBitbucket {
+	prgb := &application.PullRequestGeneratorBitbucket{
+		API:   b["api"].(string),
+		Owner: b["owner"].(string),
+		Repo:  b["repo"].(string),
+	}
+
+	if v, ok := b["basic_auth"].([]interface{}); ok && len(v) > 0 {
+		ba := v[0].(map[string]interface{})
+
+		prgb.BasicAuth = &application.BasicAuthBitbucketServer{
+			Username: ba["username"].(string),
+		}
+
+		if pr, ok := ba["password_ref"].([]interface{}); ok && len(pr) > 0 {
+			prgb.BasicAuth.PasswordRef = expandSecretRef(pr[0].(map[string]interface{}))
+		}
+	}
+
+	if v, ok := b["bearer_token"].([]interface{}); ok && len(v) > 0 {
+		bt := v[0].(map[string]interface{})
+
+		prgb.BearerToken = &application.BearerTokenBitbucketCloud{}
+
+		if tr, ok := bt["token_ref"].([]interface{}); ok && len(tr) > 0 {
+			prgb.BearerToken.TokenRef = expandSecretRef(tr[0].(map[string]interface{}))
+		}
+	}
+
+	return prgb
+}
+
 func expandApplicationSetPullRequestGeneratorBitbucketServer(bs map[string]interface{}) *application.PullRequestGeneratorBitbucketServer {
 	spgbs := &application.PullRequestGeneratorBitbucketServer{
 		API:     bs["api"].(string),
@@ -668,7 +706,9 @@ func expandApplicationSetSCMProviderGenerator(mg interface{}, featureMultipleApp
 		},
 	}
 
-	if v, ok := m["azure_devops"].([]interface{}); ok && len(v) > 0 {
+	if v, ok := m["aws_code_commit"].([]interface{}); ok && len(v) > 0 {
+		asg.SCMProvider.AWSCodeCommit = expandApplicationSetSCMProviderAWSCodeCommit(v[0].(map[string]interface{}))
+	} else if v, ok := m["azure_devops"].([]interface{}); ok && len(v) > 0 {
 		asg.SCMProvider.AzureDevOps = expandApplicationSetSCMProviderAzureDevOps(v[0].(map[string]interface{}))
 	} else if v, ok := m["bitbucket_cloud"].([]interface{}); ok && len(v) > 0 {
 		asg.SCMProvider.Bitbucket = expandApplicationSetSCMProviderBitbucket(v[0].(map[string]interface{}))
@@ -707,6 +747,27 @@ func expandApplicationSetSCMProviderGenerator(mg interface{}, featureMultipleApp
 	return asg, nil
 }
 
+func expandApplicationSetSCMProviderAWSCodeCommit(cc map[string]interface{}) *application.SCMProviderGeneratorAWSCodeCommit {
+	spgcc := &application.SCMProviderGeneratorAWSCodeCommit{
+		AllBranches: cc["all_branches"].(bool),
+		Region:      cc["region"].(string),
+		Role:        cc["role"].(string),
+	}
+
+	if v, ok := cc["tag_filter"].([]interface{}); ok && len(v) > 0 {
+		for _, tf := range v {
+			f := tf.(map[string]interface{})
+
+			spgcc.TagFilters = append(spgcc.TagFilters, &application.TagFilter{
+				Key:   f["key"].(string),
+				Value: f["value"].(string),
+			})
+		}
+	}
+
+	return spgcc
+}
+
 func expandApplicationSetSCMProviderAzureDevOps(ado map[string]interface{}) *application.SCMProviderGeneratorAzureDevOps {
 	spgado := &application.SCMProviderGeneratorAzureDevOps{
 		AllBranches:  ado["all_branches"].(bool),
@@ -1344,6 +1405,8 @@ func flattenApplicationSetPullRequestGenerator(prg *application.PullRequestGener
 
 	if prg.AzureDevOps != nil {
 		g["azure_devops"] = flattenApplicationSetPullRequestGeneratorAzureDevOps(prg.AzureDevOps)
+	} else if prg.Bitbucket != nil {
+		g["bitbucket_cloud"] = flattenApplicationSetPullRequestGeneratorBitbucket(prg.Bitbucket)
 	} else if prg.BitbucketServer != nil {
 		g["bitbucket_server"] = flattenApplicationSetPullRequestGeneratorBitbucketServer(prg.BitbucketServer)
 	} else if prg.Gitea != nil {
@@ -1362,6 +1425,8 @@ func flattenApplicationSetPullRequestGenerator(prg *application.PullRequestGener
 		g["requeue_after_seconds"] = convertInt64PointerToString(prg.RequeueAfterSeconds)
 	}
 
+	g["continue_on_repo_not_found_error"] = prg.ContinueOnRepoNotFoundError
+
 	g["template"] = flattenApplicationSetTemplate(prg.Template)
 
 	g["values"] = prg.Values
@@ -1369,6 +1434,38 @@ func flattenApplicationSetPullRequestGenerator(prg *application.PullRequestGener
 	return []map[string]interface{}{g}
 }
 
+func flattenApplicationSetPullRequestGeneratorBitbucket(prgb *application.PullRequestGeneratorBitbucket) []map[string]interface{} {
+	bb := map[string]interface{}{
+		"api":   prgb.API,
+		"owner": prgb.Owner,
+		"repo":  prgb.Repo,
+	}
+
+	if prgb.BasicAuth != nil {
+		ba := map[string]interface{}{
+			"username": prgb.BasicAuth.Username,
+		}
+
+		if prgb.BasicAuth.PasswordRef != nil {
+			ba["password_ref"] = flattenSecretRef(*prgb.BasicAuth.PasswordRef)
+		}
+
+		bb["basic_auth"] = []map[string]interface{}{ba}
+	}
+
+	if prgb.BearerToken != nil {
+		bt := map[string]interface{}{}
+
+		if prgb.BearerToken.TokenRef != nil {
+			bt["token_ref"] = flattenSecretRef(*prgb.BearerToken.TokenRef)
+		}
+
+		bb["bearer_token"] = []map[string]interface{}{bt}
+	}
+
+	return []map[string]interface{}{bb}
+}
+
 func flattenApplicationSetPullRequestGeneratorBitbucketServer(prgbs *application.PullRequestGeneratorBitbucketServer) []map[string]interface{} {
 	bb := map[string]interface{}{
 		"api":     prgbs.API,
@@ -1490,7 +1587,9 @@ func flattenApplicationSetSCMProviderGenerator(spg *application.SCMProviderGener
 		"clone_protocol": spg.CloneProtocol,
 	}
 
-	if spg.AzureDevOps != nil {
+	if spg.AWSCodeCommit != nil {
+		g["aws_code_commit"] = flattenApplicationSetSCMProviderGeneratorAWSCodeCommit(spg.AWSCodeCommit)
+	} else if spg.AzureDevOps != nil {
 		g["azure_devops"] = flattenApplicationSetSCMProviderGeneratorAzureDevOps(spg.AzureDevOps)
 	} else if spg.Bitbucket != nil {
 		g["bitbucket_cloud"] = flattenApplicationSetSCMProviderGeneratorBitbucket(spg.Bitbucket)
@@ -1517,6 +1616,33 @@ func flattenApplicationSetSCMProviderGenerator(spg *application.SCMProviderGener
 	return []map[string]interface{}{g}
 }
 
+func flattenApplicationSetSCMProviderGeneratorAWSCodeCommit(spgcc *application.SCMProviderGeneratorAWSCodeCommit) []map[string]interface{} {
+	cc := map[string]interface{}{
+		"all_branches": spgcc.AllBranches,
+		"region":       spgcc.Region,
+		"role":         spgcc.Role,
+	}
+
+	if len(spgcc.TagFilters) > 0 {
+		tfs := make([]map[string]interface{}, 0, len(spgcc.TagFilters))
+
+		for _, tf := range spgcc.TagFilters {
+			if tf == nil {
+				continue
+			}
+
+			tfs = append(tfs, map[string]interface{}{
+				"key":   tf.Key,
+				"value": tf.Value,
+			})
+		}
+
+		cc["tag_filter"] = tfs
+	}
+
+	return []map[string]interface{}{cc}
+}
+
 func flattenApplicationSetSCMProviderGeneratorAzureDevOps(spgado *application.SCMProviderGeneratorAzureDevOps) []map[string]interface{} {
 	a := map[string]interface{}{
 		"all_branches": spgado.AllBranches,
@@ -3,6 +3,8 @@ package argocd
 import (
 	"reflect"
 	"testing"
+
+	application "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
 )
 
 func TestExpandFlattenApplicationSetPullRequestGeneratorValues(t *testing.T) {
@@ -38,3 +40,161 @@ func TestExpandFlattenApplicationSetPullRequestGeneratorValues(t *testing.T) {
 		t.Fatalf("expected flattened values %v, got %v", expectedValues, flattened[0]["values"])
 	}
 }
+
+func TestExpandFlattenApplicationSetSCMProviderGeneratorAWSCodeCommit(t *testing.T) {
+	t.Parallel()
+
+	input := map[string]interface{}{
+		"clone_protocol": "https",
+		"aws_code_commit": []interface{}{
+			map[string]interface{}{
+				"all_branches": true,
+				"region":       "eu-west-1",
+				"role":         "arn:aws:iam::111111111111:role/argocd-commit-discovery",
+				"tag_filter": []interface{}{
+					map[string]interface{}{
+						"key":   "organization",
+						"value": "platform-engineering",
+					},
+					map[string]interface{}{
+						"key":   "argo-ready",
+						"value": "",
+					},
+				},
+			},
+		},
+	}
+
+	asg, err := expandApplicationSetSCMProviderGenerator(input, false, false)
+	if err != nil {
+		t.Fatalf("unexpected error expanding SCM provider generator: %s", err)
+	}
+
+	expected := &application.SCMProviderGeneratorAWSCodeCommit{
+		AllBranches: true,
+		Region:      "eu-west-1",
+		Role:        "arn:aws:iam::111111111111:role/argocd-commit-discovery",
+		TagFilters: []*application.TagFilter{
+			{Key: "organization", Value: "platform-engineering"},
+			{Key: "argo-ready"},
+		},
+	}
+
+	if !reflect.DeepEqual(asg.SCMProvider.AWSCodeCommit, expected) {
+		t.Fatalf("expected expanded AWSCodeCommit %#v, got %#v", expected, asg.SCMProvider.AWSCodeCommit)
+	}
+
+	flattened := flattenApplicationSetSCMProviderGenerator(asg.SCMProvider)
+	if len(flattened) != 1 {
+		t.Fatalf("expected exactly one flattened SCM provider generator, got %d", len(flattened))
+	}
+
+	expectedFlattened := []map[string]interface{}{
+		{
+			"all_branches": true,
+			"region":       "eu-west-1",
+			"role":         "arn:aws:iam::111111111111:role/argocd-commit-discovery",
+			"tag_filter": []map[string]interface{}{
+				{"key": "organization", "value": "platform-engineering"},
+				{"key": "argo-ready", "value": ""},
+			},
+		},
+	}
+
+	if !reflect.DeepEqual(flattened[0]["aws_code_commit"], expectedFlattened) {
+		t.Fatalf("expected flattened aws_code_commit %v, got %v", expectedFlattened, flattened[0]["aws_code_commit"])
+	}
+}
+
+func TestExpandFlattenApplicationSetPullRequestGeneratorBitbucketCloud(t *testing.T) {
+	t.Parallel()
+
+	input := map[string]interface{}{
+		"continue_on_repo_not_found_error": true,
+		"bitbucket_cloud": []interface{}{
+			map[string]interface{}{
+				"api":   "",
+				"owner": "myworkspace",
+				"repo":  "myrepository",
+				"basic_auth": []interface{}{
+					map[string]interface{}{
+						"username": "myuser",
+						"password_ref": []interface{}{
+							map[string]interface{}{
+								"secret_name": "mypassword",
+								"key":         "password",
+							},
+						},
+					},
+				},
+				"bearer_token": []interface{}{
+					map[string]interface{}{
+						"token_ref": []interface{}{
+							map[string]interface{}{
+								"secret_name": "mytoken",
+								"key":         "token",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	asg, err := expandApplicationSetPullRequestGeneratorGenerator(input, false, false)
+	if err != nil {
+		t.Fatalf("unexpected error expanding pull request generator: %s", err)
+	}
+
+	if !asg.PullRequest.ContinueOnRepoNotFoundError {
+		t.Fatal("expected ContinueOnRepoNotFoundError to be true")
+	}
+
+	expected := &application.PullRequestGeneratorBitbucket{
+		Owner: "myworkspace",
+		Repo:  "myrepository",
+		BasicAuth: &application.BasicAuthBitbucketServer{
+			Username:    "myuser",
+			PasswordRef: &application.SecretRef{SecretName: "mypassword", Key: "password"},
+		},
+		BearerToken: &application.BearerTokenBitbucketCloud{
+			TokenRef: &application.SecretRef{SecretName: "mytoken", Key: "token"},
+		},
+	}
+
+	if !reflect.DeepEqual(asg.PullRequest.Bitbucket, expected) {
+		t.Fatalf("expected expanded Bitbucket %#v, got %#v", expected, asg.PullRequest.Bitbucket)
+	}
+
+	flattened := flattenApplicationSetPullRequestGenerator(asg.PullRequest)
+	if len(flattened) != 1 {
+		t.Fatalf("expected exactly one flattened pull request generator, got %d", len(flattened))
+	}
+
+	if flattened[0]["continue_on_repo_not_found_error"] != true {
+		t.Fatalf("expected flattened continue_on_repo_not_found_error to be true, got %v", flattened[0]["continue_on_repo_not_found_error"])
+	}
+
+	expectedFlattened := []map[string]interface{}{
+		{
+			"api":   "",
+			"owner": "myworkspace",
+			"repo":  "myrepository",
+			"basic_auth": []map[string]interface{}{
+				{
+					"username":     "myuser",
+					"password_ref": flattenSecretRef(application.SecretRef{SecretName: "mypassword", Key: "password"}),
+				},
+			},
+			"bearer_token": []map[string]interface{}{
+				{
+					"token_ref": flattenSecretRef(application.SecretRef{SecretName: "mytoken", Key: "token"}),
+				},
+			},
+		},
+	}
+
+	if !reflect.DeepEqual(flattened[0]["bitbucket_cloud"], expectedFlattened) {
+		t.Fatalf("expected flattened bitbucket_cloud %v, got %v", expectedFlattened, flattened[0]["bitbucket_cloud"])
+	}
+}
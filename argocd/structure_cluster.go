@@ -2,9 +2,13 @@ package argocd
 
 import (
 	"fmt"
+	"os"
+	"strings"
 
 	application "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
 )
 
 func expandCluster(d *schema.ResourceData) (*application.Cluster, error) {
@@ -38,7 +42,27 @@ func expandCluster(d *schema.ResourceData) (*application.Cluster, error) {
 	}
 
 	if v, ok := d.GetOk("config"); ok {
-		cluster.Config = expandClusterConfig(v.([]interface{})[0])
+		config, err := expandClusterConfig(v.([]interface{})[0])
+		if err != nil {
+			return nil, fmt.Errorf("config: %w", err)
+		}
+
+		cluster.Config = config
+	}
+
+	if v, ok := d.GetOk("kubeconfig"); ok {
+		k := v.([]interface{})[0].(map[string]interface{})
+
+		server, config, err := expandClusterKubeconfig(k["raw"].(string), k["context"].(string))
+		if err != nil {
+			return nil, fmt.Errorf("kubeconfig: %w", err)
+		}
+
+		if cluster.Server == "" {
+			cluster.Server = server
+		}
+
+		cluster.Config = config
 	}
 
 	m := expandMetadata(d)
@@ -52,7 +76,7 @@ func expandCluster(d *schema.ResourceData) (*application.Cluster, error) {
 	return cluster, nil
 }
 
-func expandClusterConfig(config interface{}) application.ClusterConfig {
+func expandClusterConfig(config interface{}) (application.ClusterConfig, error) {
 	clusterConfig := application.ClusterConfig{}
 
 	c := config.(map[string]interface{})
@@ -127,17 +151,183 @@ func expandClusterConfig(config interface{}) application.ClusterConfig {
 		}
 	}
 
-	return clusterConfig
+	if azure, ok := c["azure_auth_config"].([]interface{}); ok && len(azure) > 0 {
+		epc, err := expandClusterAzureAuthConfig(azure[0])
+		if err != nil {
+			return clusterConfig, fmt.Errorf("azure_auth_config: %w", err)
+		}
+
+		clusterConfig.ExecProviderConfig = epc
+	}
+
+	if gcp, ok := c["gcp_auth_config"].([]interface{}); ok && len(gcp) > 0 {
+		clusterConfig.ExecProviderConfig = expandClusterGCPAuthConfig(gcp[0])
+	}
+
+	return clusterConfig, nil
+}
+
+// expandClusterAzureAuthConfig translates the Azure authentication settings
+// into an invocation of argocd-k8s-auth, which wraps kubelogin.
+func expandClusterAzureAuthConfig(config interface{}) (*application.ExecProviderConfig, error) {
+	epc := &application.ExecProviderConfig{
+		Command:    "argocd-k8s-auth",
+		Args:       []string{"azure"},
+		APIVersion: "client.authentication.k8s.io/v1beta1",
+		Env:        map[string]string{"AAD_LOGIN_METHOD": "workloadidentity"},
+	}
+
+	c, _ := config.(map[string]interface{})
+
+	for k, env := range map[string]string{
+		"login_method":          "AAD_LOGIN_METHOD",
+		"client_id":             "AZURE_CLIENT_ID",
+		"tenant_id":             "AZURE_TENANT_ID",
+		"client_secret":         "AZURE_CLIENT_SECRET",
+		"federated_token_file":  "AZURE_FEDERATED_TOKEN_FILE",
+		"authority_host":        "AZURE_AUTHORITY_HOST",
+		"environment":           "AAD_ENVIRONMENT_NAME",
+		"server_application_id": "AAD_SERVER_APPLICATION_ID",
+	} {
+		if v, ok := c[k].(string); ok && v != "" {
+			epc.Env[env] = v
+		}
+	}
+
+	if epc.Env["AAD_LOGIN_METHOD"] == "spn" {
+		for _, env := range []string{"AZURE_CLIENT_ID", "AZURE_TENANT_ID", "AZURE_CLIENT_SECRET"} {
+			if _, ok := epc.Env[env]; !ok {
+				return nil, fmt.Errorf("client_id, tenant_id and client_secret must be set when using the spn login method")
+			}
+		}
+	}
+
+	return epc, nil
+}
+
+// expandClusterGCPAuthConfig translates the GCP authentication settings into an
+// invocation of argocd-k8s-auth.
+func expandClusterGCPAuthConfig(config interface{}) *application.ExecProviderConfig {
+	epc := &application.ExecProviderConfig{
+		Command:    "argocd-k8s-auth",
+		Args:       []string{"gcp"},
+		APIVersion: "client.authentication.k8s.io/v1beta1",
+	}
+
+	if c, ok := config.(map[string]interface{}); ok {
+		if v, ok := c["credentials_file"].(string); ok && v != "" {
+			epc.Env = map[string]string{"GOOGLE_APPLICATION_CREDENTIALS": v}
+		}
+	}
+
+	return epc
+}
+
+// clusterKubeconfigServer returns the server address of the given kubeconfig
+// context, without resolving its credentials.
+func clusterKubeconfigServer(raw, contextName string) (string, error) {
+	kc, err := clientcmd.Load([]byte(raw))
+	if err != nil {
+		return "", fmt.Errorf("failed to parse kubeconfig: %w", err)
+	}
+
+	if contextName == "" {
+		contextName = kc.CurrentContext
+	}
+
+	c, ok := kc.Contexts[contextName]
+	if !ok {
+		return "", fmt.Errorf("context %q not found", contextName)
+	}
+
+	cluster, ok := kc.Clusters[c.Cluster]
+	if !ok {
+		return "", fmt.Errorf("cluster %q of context %q not found", c.Cluster, contextName)
+	}
+
+	return cluster.Server, nil
+}
+
+// expandClusterKubeconfig resolves the given kubeconfig context into the
+// server address and connection configuration of the cluster.
+func expandClusterKubeconfig(raw, contextName string) (string, application.ClusterConfig, error) {
+	clusterConfig := application.ClusterConfig{}
+
+	kc, err := clientcmd.Load([]byte(raw))
+	if err != nil {
+		return "", clusterConfig, fmt.Errorf("failed to parse kubeconfig: %w", err)
+	}
+
+	rc, err := clientcmd.NewNonInteractiveClientConfig(*kc, contextName, &clientcmd.ConfigOverrides{}, nil).ClientConfig()
+	if err != nil {
+		return "", clusterConfig, fmt.Errorf("failed to load context %q: %w", contextName, err)
+	}
+
+	if rc.AuthProvider != nil {
+		return "", clusterConfig, fmt.Errorf("auth provider %q is not supported, use an exec plugin instead", rc.AuthProvider.Name)
+	}
+
+	// Inline the contents of certificate files
+	if err = rest.LoadTLSFiles(rc); err != nil {
+		return "", clusterConfig, fmt.Errorf("failed to read TLS files: %w", err)
+	}
+
+	clusterConfig.BearerToken = rc.BearerToken
+	if rc.BearerTokenFile != "" {
+		token, err := os.ReadFile(rc.BearerTokenFile)
+		if err != nil {
+			return "", clusterConfig, fmt.Errorf("failed to read token file: %w", err)
+		}
+
+		clusterConfig.BearerToken = strings.TrimSpace(string(token))
+	}
+
+	clusterConfig.Username = rc.Username
+	clusterConfig.Password = rc.Password
+	clusterConfig.TLSClientConfig = application.TLSClientConfig{
+		Insecure:   rc.Insecure,
+		ServerName: rc.ServerName,
+		CAData:     rc.CAData,
+		CertData:   rc.CertData,
+		KeyData:    rc.KeyData,
+	}
+
+	if rc.ExecProvider != nil {
+		clusterConfig.ExecProviderConfig = &application.ExecProviderConfig{
+			Command:     rc.ExecProvider.Command,
+			Args:        rc.ExecProvider.Args,
+			APIVersion:  rc.ExecProvider.APIVersion,
+			InstallHint: rc.ExecProvider.InstallHint,
+		}
+
+		if len(rc.ExecProvider.Env) > 0 {
+			clusterConfig.ExecProviderConfig.Env = make(map[string]string, len(rc.ExecProvider.Env))
+			for _, e := range rc.ExecProvider.Env {
+				clusterConfig.ExecProviderConfig.Env[e.Name] = e.Value
+			}
+		}
+	}
+
+	return rc.Host, clusterConfig, nil
 }
 
 func flattenCluster(cluster *application.Cluster, d *schema.ResourceData) error {
 	r := map[string]interface{}{
-		"name":       cluster.Name,
-		"server":     cluster.Server,
-		"namespaces": cluster.Namespaces,
-		"info":       flattenClusterInfo(cluster.Info),
-		"config":     flattenClusterConfig(cluster.Config, d),
-		"project":    cluster.Project,
+		"name":           cluster.Name,
+		"server":         cluster.Server,
+		"namespaces":     cluster.Namespaces,
+		"info":           flattenClusterInfo(cluster.Info),
+		"project":        cluster.Project,
+		"project_scoped": cluster.Project != "",
+	}
+
+	// The connection configuration is derived from the kubeconfig, or replaced
+	// by the bootstrapped ServiceAccount token, and is not tracked separately
+	_, kubeconfig := d.GetOk("kubeconfig")
+	_, bootstrapped := d.GetOk("bootstrap_rbac")
+
+	if !kubeconfig && !bootstrapped {
+		r["config"] = flattenClusterConfig(cluster.Config, d)
 	}
 
 	if len(cluster.Annotations) != 0 || len(cluster.Labels) != 0 {
@@ -181,6 +371,17 @@ func flattenClusterConfig(config application.ClusterConfig, d *schema.ResourceDa
 		"tls_client_config":    flattenClusterConfigTLSClientConfig(config.TLSClientConfig, d),
 	}
 
+	// The Azure and GCP settings are translated into an exec provider
+	// configuration, which is not returned by the ArgoCD API either. Thus, they
+	// are loaded from state as well.
+	if v, ok := d.Get("config.0.azure_auth_config").([]interface{}); ok && len(v) > 0 {
+		r["azure_auth_config"] = v
+	}
+
+	if v, ok := d.Get("config.0.gcp_auth_config").([]interface{}); ok && len(v) > 0 {
+		r["gcp_auth_config"] = v
+	}
+
 	if config.AWSAuthConfig != nil {
 		r["aws_auth_config"] = []map[string]string{
 			{
@@ -0,0 +1,290 @@
+package argocd
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	application "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExpandClusterKubeconfig(t *testing.T) {
+	t.Parallel()
+
+	tokenFile := filepath.Join(t.TempDir(), "token")
+	require.NoError(t, os.WriteFile(tokenFile, []byte("file-token\n"), 0o600))
+
+	raw := fmt.Sprintf(`
+apiVersion: v1
+kind: Config
+current-context: token
+clusters:
+- name: token
+  cluster:
+    server: https://token.example.com
+    certificate-authority-data: Y2EtZGF0YQ==
+- name: exec
+  cluster:
+    server: https://exec.example.com
+    insecure-skip-tls-verify: true
+    tls-server-name: exec.internal
+users:
+- name: token
+  user:
+    tokenFile: %s
+    client-certificate-data: Y2VydC1kYXRh
+    client-key-data: a2V5LWRhdGE=
+- name: exec
+  user:
+    exec:
+      apiVersion: client.authentication.k8s.io/v1beta1
+      command: aws
+      args: ["eks", "get-token", "--cluster-name", "exec"]
+      env:
+      - name: AWS_PROFILE
+        value: prod
+      interactiveMode: Never
+- name: auth-provider
+  user:
+    auth-provider:
+      name: oidc
+contexts:
+- name: token
+  context:
+    cluster: token
+    user: token
+- name: exec
+  context:
+    cluster: exec
+    user: exec
+- name: auth-provider
+  context:
+    cluster: token
+    user: auth-provider
+`, tokenFile)
+
+	testCases := []struct {
+		name           string
+		context        string
+		expectedServer string
+		expectedConfig application.ClusterConfig
+		expectedError  string
+	}{
+		{
+			name:           "current context with token file and client certificate",
+			expectedServer: "https://token.example.com",
+			expectedConfig: application.ClusterConfig{
+				BearerToken: "file-token",
+				TLSClientConfig: application.TLSClientConfig{
+					CAData:   []byte("ca-data"),
+					CertData: []byte("cert-data"),
+					KeyData:  []byte("key-data"),
+				},
+			},
+		},
+		{
+			name:           "exec plugin",
+			context:        "exec",
+			expectedServer: "https://exec.example.com",
+			expectedConfig: application.ClusterConfig{
+				TLSClientConfig: application.TLSClientConfig{
+					Insecure:   true,
+					ServerName: "exec.internal",
+				},
+				ExecProviderConfig: &application.ExecProviderConfig{
+					Command:    "aws",
+					Args:       []string{"eks", "get-token", "--cluster-name", "exec"},
+					Env:        map[string]string{"AWS_PROFILE": "prod"},
+					APIVersion: "client.authentication.k8s.io/v1beta1",
+				},
+			},
+		},
+		{
+			name:          "auth provider",
+			context:       "auth-provider",
+			expectedError: `auth provider "oidc" is not supported`,
+		},
+		{
+			name:          "unknown context",
+			context:       "unknown",
+			expectedError: `failed to load context "unknown"`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			server, config, err := expandClusterKubeconfig(raw, tc.context)
+			if tc.expectedError != "" {
+				require.ErrorContains(t, err, tc.expectedError)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedServer, server)
+			assert.Equal(t, tc.expectedConfig, config)
+		})
+	}
+}
+
+func TestClusterKubeconfigServer(t *testing.T) {
+	t.Parallel()
+
+	raw := `
+apiVersion: v1
+kind: Config
+current-context: prod
+clusters:
+- name: prod
+  cluster:
+    server: https://prod.example.com
+- name: dev
+  cluster:
+    server: https://dev.example.com
+users:
+- name: prod
+  user:
+    tokenFile: /does/not/exist
+contexts:
+- name: prod
+  context:
+    cluster: prod
+    user: prod
+- name: dev
+  context:
+    cluster: dev
+    user: prod
+`
+
+	// Credentials are not resolved, so that missing files do not fail the plan
+	server, err := clusterKubeconfigServer(raw, "")
+	require.NoError(t, err)
+	assert.Equal(t, "https://prod.example.com", server)
+
+	server, err = clusterKubeconfigServer(raw, "dev")
+	require.NoError(t, err)
+	assert.Equal(t, "https://dev.example.com", server)
+
+	_, err = clusterKubeconfigServer(raw, "missing")
+	assert.Error(t, err)
+}
+
+func TestExpandClusterConfig_cloudAuth(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		config   map[string]interface{}
+		expected *application.ExecProviderConfig
+		err      string
+	}{
+		{
+			name: "azure workload identity",
+			config: map[string]interface{}{
+				"azure_auth_config": []interface{}{
+					map[string]interface{}{
+						"login_method": "workloadidentity",
+						"client_id":    "00000000-0000-0000-0000-000000000001",
+						"environment":  "AzureChinaCloud",
+						"tenant_id":    "",
+					},
+				},
+			},
+			expected: &application.ExecProviderConfig{
+				Command:    "argocd-k8s-auth",
+				Args:       []string{"azure"},
+				APIVersion: "client.authentication.k8s.io/v1beta1",
+				Env: map[string]string{
+					"AAD_LOGIN_METHOD":     "workloadidentity",
+					"AZURE_CLIENT_ID":      "00000000-0000-0000-0000-000000000001",
+					"AAD_ENVIRONMENT_NAME": "AzureChinaCloud",
+				},
+			},
+		},
+		{
+			name: "azure service principal",
+			config: map[string]interface{}{
+				"azure_auth_config": []interface{}{
+					map[string]interface{}{
+						"login_method":  "spn",
+						"client_id":     "00000000-0000-0000-0000-000000000001",
+						"tenant_id":     "00000000-0000-0000-0000-000000000002",
+						"client_secret": "secret",
+					},
+				},
+			},
+			expected: &application.ExecProviderConfig{
+				Command:    "argocd-k8s-auth",
+				Args:       []string{"azure"},
+				APIVersion: "client.authentication.k8s.io/v1beta1",
+				Env: map[string]string{
+					"AAD_LOGIN_METHOD":    "spn",
+					"AZURE_CLIENT_ID":     "00000000-0000-0000-0000-000000000001",
+					"AZURE_TENANT_ID":     "00000000-0000-0000-0000-000000000002",
+					"AZURE_CLIENT_SECRET": "secret",
+				},
+			},
+		},
+		{
+			name: "azure service principal without secret",
+			config: map[string]interface{}{
+				"azure_auth_config": []interface{}{
+					map[string]interface{}{
+						"login_method": "spn",
+						"client_id":    "00000000-0000-0000-0000-000000000001",
+						"tenant_id":    "00000000-0000-0000-0000-000000000002",
+					},
+				},
+			},
+			err: "azure_auth_config: client_id, tenant_id and client_secret must be set when using the spn login method",
+		},
+		{
+			name: "gcp default credentials",
+			config: map[string]interface{}{
+				"gcp_auth_config": []interface{}{nil},
+			},
+			expected: &application.ExecProviderConfig{
+				Command:    "argocd-k8s-auth",
+				Args:       []string{"gcp"},
+				APIVersion: "client.authentication.k8s.io/v1beta1",
+			},
+		},
+		{
+			name: "gcp credentials file",
+			config: map[string]interface{}{
+				"gcp_auth_config": []interface{}{
+					map[string]interface{}{
+						"credentials_file": "/var/run/secrets/gcp/key.json",
+					},
+				},
+			},
+			expected: &application.ExecProviderConfig{
+				Command:    "argocd-k8s-auth",
+				Args:       []string{"gcp"},
+				APIVersion: "client.authentication.k8s.io/v1beta1",
+				Env: map[string]string{
+					"GOOGLE_APPLICATION_CREDENTIALS": "/var/run/secrets/gcp/key.json",
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			config, err := expandClusterConfig(tt.config)
+			if tt.err != "" {
+				require.EqualError(t, err, tt.err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, config.ExecProviderConfig)
+		})
+	}
+}
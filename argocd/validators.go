@@ -1,16 +1,48 @@
 package argocd
 
 import (
+	"bytes"
 	"fmt"
 	"regexp"
+	"slices"
 	"strings"
+	"text/template"
 	"time"
 	_ "time/tzdata"
 
+	"github.com/Masterminds/sprig/v3"
 	apiValidation "k8s.io/apimachinery/pkg/api/validation"
 	utilValidation "k8s.io/apimachinery/pkg/util/validation"
+	"sigs.k8s.io/yaml"
 )
 
+// goTemplateFuncMap mirrors the functions made available to Go templates by
+// the ArgoCD ApplicationSet controller so that templates can be parsed at plan
+// time. The ArgoCD specific functions are stubs as only their signature
+// matters for parsing.
+var goTemplateFuncMap = func() template.FuncMap {
+	fm := sprig.TxtFuncMap()
+
+	delete(fm, "env")
+	delete(fm, "expandenv")
+	delete(fm, "getHostByName")
+
+	fm["normalize"] = func(string) string { return "" }
+	fm["slugify"] = func(...any) string { return "" }
+	fm["toYaml"] = func(any) string { return "" }
+	fm["fromYaml"] = func(string) map[string]any { return nil }
+	fm["fromYamlArray"] = func(string) []any { return nil }
+
+	return fm
+}()
+
+var validGoTemplateOptions = []string{
+	"missingkey=default",
+	"missingkey=invalid",
+	"missingkey=zero",
+	"missingkey=error",
+}
+
 func validateMetadataLabels(isAppSet bool) func(value interface{}, key string) (ws []string, es []error) {
 	return func(value interface{}, key string) (ws []string, es []error) {
 		m := value.(map[string]interface{})
@@ -86,3 +118,60 @@ func validateIntOrStringPercentage(value interface{}, key string) (ws []string,
 
 	return
 }
+
+func validateGoTemplateOptions(options []string) error {
+	for _, o := range options {
+		if !slices.Contains(validGoTemplateOptions, o) {
+			return fmt.Errorf("invalid go template option '%s': must be one of %s", o, strings.Join(validGoTemplateOptions, ", "))
+		}
+	}
+
+	return nil
+}
+
+func parseGoTemplate(tmpl string, options []string) (*template.Template, error) {
+	if err := validateGoTemplateOptions(options); err != nil {
+		return nil, err
+	}
+
+	t := template.New("").Funcs(goTemplateFuncMap)
+
+	for _, o := range options {
+		t = t.Option(o)
+	}
+
+	return t.Parse(tmpl)
+}
+
+// validateTemplatePatch checks that an application set template patch is a
+// valid Go template (when goTemplate is set) and that it renders to valid YAML.
+// As generator parameters are not known at plan time, the YAML check is
+// skipped whenever the template cannot be rendered without them.
+func validateTemplatePatch(patch string, goTemplate bool, options []string) error {
+	rendered := patch
+
+	if goTemplate {
+		if _, err := parseGoTemplate(patch, options); err != nil {
+			return fmt.Errorf("invalid go template: %w", err)
+		}
+
+		// Render without parameters using the default missingkey behaviour
+		// since missing keys are expected here.
+		t, _ := parseGoTemplate(patch, nil)
+
+		var buf bytes.Buffer
+		if err := t.Execute(&buf, map[string]any{}); err != nil {
+			return nil
+		}
+
+		rendered = buf.String()
+	} else if strings.Contains(patch, "{{") {
+		return nil
+	}
+
+	if _, err := yaml.YAMLToJSON([]byte(rendered)); err != nil {
+		return fmt.Errorf("invalid YAML: %w", err)
+	}
+
+	return nil
+}
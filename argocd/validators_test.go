@@ -122,3 +122,111 @@ func Test_validateMetadataLabels(t *testing.T) {
 		})
 	}
 }
+
+func Test_parseGoTemplate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		tmpl    string
+		options []string
+		wantErr bool
+	}{
+		{
+			name: "Valid template",
+			tmpl: "{{ .name | normalize }}-{{ .path.basename }}",
+		},
+		{
+			name:    "Valid template with missingkey=error",
+			tmpl:    "{{ .name }}",
+			options: []string{"missingkey=error"},
+		},
+		{
+			name:    "Unclosed action",
+			tmpl:    "{{ .name ",
+			wantErr: true,
+		},
+		{
+			name:    "Unknown function",
+			tmpl:    "{{ .name | doesNotExist }}",
+			wantErr: true,
+		},
+		{
+			name:    "Disallowed function",
+			tmpl:    "{{ env \"HOME\" }}",
+			wantErr: true,
+		},
+		{
+			name:    "Invalid option",
+			tmpl:    "{{ .name }}",
+			options: []string{"missingkey=explode"},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := parseGoTemplate(tt.tmpl, tt.options)
+			if tt.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func Test_validateTemplatePatch(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		patch      string
+		goTemplate bool
+		options    []string
+		wantErr    bool
+	}{
+		{
+			name:       "Valid go template patch",
+			patch:      "spec:\n  source:\n    helm:\n      valueFiles:\n      {{- range $valueFile := .valueFiles }}\n        - {{ $valueFile }}\n      {{- end }}\n",
+			goTemplate: true,
+			options:    []string{"missingkey=error"},
+		},
+		{
+			name:       "Invalid go template patch",
+			patch:      "spec:\n  project: {{ .project\n",
+			goTemplate: true,
+			wantErr:    true,
+		},
+		{
+			name:       "Go template patch rendering to invalid YAML",
+			patch:      "spec:\n  project: {{ .project }}\n   source: foo\n",
+			goTemplate: true,
+			wantErr:    true,
+		},
+		{
+			name:    "Invalid YAML patch",
+			patch:   "spec: [",
+			wantErr: true,
+		},
+		{
+			name:  "Non-go template patch with placeholders is not validated",
+			patch: "spec:\n  project: {{project}}\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := validateTemplatePatch(tt.patch, tt.goTemplate, tt.options)
+			if tt.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,3 @@
+data "argocd_account" "admin" {
+  name = "admin"
+}
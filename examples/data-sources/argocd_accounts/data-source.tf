@@ -0,0 +1,5 @@
+data "argocd_accounts" "all" {}
+
+output "api_key_accounts" {
+  value = [for a in data.argocd_accounts.all.accounts : a.name if contains(a.capabilities, "apiKey")]
+}
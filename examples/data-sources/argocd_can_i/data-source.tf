@@ -0,0 +1,14 @@
+data "argocd_can_i" "sync_apps" {
+  resource    = "applications"
+  action      = "sync"
+  subresource = "my-project/*"
+}
+
+resource "terraform_data" "deploy" {
+  lifecycle {
+    precondition {
+      condition     = data.argocd_can_i.sync_apps.allowed
+      error_message = "The ArgoCD token used by this workspace is not allowed to sync applications in my-project."
+    }
+  }
+}
@@ -0,0 +1,11 @@
+data "argocd_cluster" "in_cluster" {
+  server = "https://kubernetes.default.svc"
+}
+
+data "argocd_cluster" "by_name" {
+  name = "staging"
+}
+
+output "in_cluster_version" {
+  value = data.argocd_cluster.in_cluster.info.server_version
+}
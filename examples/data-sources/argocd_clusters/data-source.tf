@@ -0,0 +1,45 @@
+data "argocd_clusters" "production" {
+  labels = {
+    environment = "production"
+  }
+}
+
+resource "argocd_application_set" "guestbook" {
+  metadata {
+    name = "guestbook"
+  }
+
+  spec {
+    generator {
+      list {
+        elements = [
+          for c in data.argocd_clusters.production.clusters : {
+            cluster = c.name
+            url     = c.server
+          }
+        ]
+      }
+    }
+
+    template {
+      metadata {
+        name = "{{cluster}}-guestbook"
+      }
+
+      spec {
+        project = "default"
+
+        source {
+          repo_url        = "https://github.com/argoproj/argocd-example-apps/"
+          target_revision = "HEAD"
+          path            = "guestbook"
+        }
+
+        destination {
+          server    = "{{url}}"
+          namespace = "guestbook"
+        }
+      }
+    }
+  }
+}
@@ -0,0 +1,7 @@
+data "argocd_project" "tenant" {
+  name = "tenant"
+}
+
+output "tenant_destinations" {
+  value = data.argocd_project.tenant.spec.destination
+}
@@ -0,0 +1,5 @@
+data "argocd_projects" "all" {}
+
+output "project_names" {
+  value = [for p in data.argocd_projects.all.projects : p.name]
+}
@@ -0,0 +1,11 @@
+data "argocd_repositories" "helm" {
+  type          = "helm"
+  force_refresh = true
+}
+
+output "failed_helm_repositories" {
+  value = [
+    for r in data.argocd_repositories.helm.repositories : r.repo
+    if r.connection_state.status != "Successful"
+  ]
+}
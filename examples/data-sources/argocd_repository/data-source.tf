@@ -0,0 +1,7 @@
+data "argocd_repository" "nginx" {
+  repo = "https://helm.nginx.com/stable"
+}
+
+output "nginx_connection_status" {
+  value = data.argocd_repository.nginx.connection_state.status
+}
@@ -0,0 +1,17 @@
+variable "github_token" {
+  type      = string
+  sensitive = true
+}
+
+# Fails the plan if ArgoCD cannot access the repository with the credentials.
+data "argocd_repository_access" "private" {
+  repo     = "https://github.com/example/private-repo.git"
+  username = "git"
+  password = var.github_token
+}
+
+resource "argocd_repository" "private" {
+  repo     = data.argocd_repository_access.private.repo
+  username = "git"
+  password = var.github_token
+}
@@ -0,0 +1,37 @@
+resource "argocd_repository" "nginx" {
+  repo = "https://helm.nginx.com/stable"
+  name = "nginx-stable"
+  type = "helm"
+}
+
+data "argocd_repository_helm_charts" "ingress" {
+  repo               = argocd_repository.nginx.repo
+  chart              = "nginx-ingress"
+  version_constraint = "~2.0"
+
+  lifecycle {
+    postcondition {
+      condition     = length(self.charts) == 1 && self.charts[0].latest_version != null
+      error_message = "No version of the nginx-ingress chart satisfies the constraint."
+    }
+  }
+}
+
+resource "argocd_application" "ingress" {
+  metadata {
+    name = "nginx-ingress"
+  }
+
+  spec {
+    source {
+      repo_url        = argocd_repository.nginx.repo
+      chart           = data.argocd_repository_helm_charts.ingress.charts[0].name
+      target_revision = data.argocd_repository_helm_charts.ingress.charts[0].latest_version
+    }
+
+    destination {
+      server    = "https://kubernetes.default.svc"
+      namespace = "nginx-ingress"
+    }
+  }
+}
@@ -0,0 +1,23 @@
+data "argocd_repository_refs" "guestbook" {
+  repo               = "https://github.com/argoproj/argocd-example-apps"
+  version_constraint = "~1.2"
+}
+
+resource "argocd_application" "guestbook" {
+  metadata {
+    name = "guestbook"
+  }
+
+  spec {
+    source {
+      repo_url        = data.argocd_repository_refs.guestbook.repo
+      target_revision = data.argocd_repository_refs.guestbook.latest_tag
+      path            = "guestbook"
+    }
+
+    destination {
+      server    = "https://kubernetes.default.svc"
+      namespace = "guestbook"
+    }
+  }
+}
@@ -0,0 +1,9 @@
+data "argocd_settings" "current" {}
+
+output "argocd_url" {
+  value = data.argocd_settings.current.url
+}
+
+output "sso_enabled" {
+  value = data.argocd_settings.current.dex_configured || data.argocd_settings.current.oidc_configured
+}
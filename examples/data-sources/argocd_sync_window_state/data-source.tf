@@ -0,0 +1,14 @@
+# Active sync windows of a project
+data "argocd_sync_window_state" "project" {
+  project = "myproject"
+}
+
+# Active sync windows matching an application and whether it can be synced
+data "argocd_sync_window_state" "application" {
+  application           = "myapp"
+  application_namespace = "argocd"
+}
+
+output "deploy_blocked_by" {
+  value = data.argocd_sync_window_state.application.can_sync ? [] : data.argocd_sync_window_state.application.active_windows
+}
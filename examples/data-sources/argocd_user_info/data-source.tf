@@ -0,0 +1,5 @@
+data "argocd_user_info" "current" {}
+
+output "argocd_identity" {
+  value = data.argocd_user_info.current.username
+}
@@ -0,0 +1,31 @@
+data "argocd_version" "current" {}
+
+resource "argocd_application" "example" {
+  metadata {
+    name      = "example"
+    namespace = "argocd"
+  }
+
+  spec {
+    destination {
+      server    = "https://kubernetes.default.svc"
+      namespace = "example"
+    }
+
+    source {
+      repo_url        = "https://github.com/argoproj/argocd-example-apps"
+      path            = "guestbook"
+      target_revision = "HEAD"
+    }
+
+    dynamic "source" {
+      for_each = data.argocd_version.current.features["multiple_application_sources"] ? [1] : []
+
+      content {
+        repo_url        = "https://github.com/argoproj/argocd-example-apps"
+        path            = "helm-guestbook"
+        target_revision = "HEAD"
+      }
+    }
+  }
+}
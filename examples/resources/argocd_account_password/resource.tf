@@ -0,0 +1,14 @@
+variable "ci_password" {
+  type      = string
+  sensitive = true
+  ephemeral = true
+}
+
+resource "argocd_account_password" "ci" {
+  account     = "ci"
+  password_wo = var.ci_password
+
+  rotation_triggers = {
+    rotated_at = "2025-01-01"
+  }
+}
@@ -14,6 +14,40 @@ resource "argocd_cluster" "kubernetes" {
   }
 }
 
+## Kubeconfig
+resource "argocd_cluster" "kubeconfig" {
+  name = "staging"
+
+  kubeconfig {
+    raw     = file("path/to/kubeconfig")
+    context = "staging"
+  }
+
+  # Fail when ArgoCD cannot connect to the cluster
+  verify_connection = true
+}
+
+## ServiceAccount bootstrapped with admin credentials
+resource "argocd_cluster" "bootstrapped" {
+  server     = "https://1.2.3.4:12345"
+  namespaces = ["default", "optional"]
+
+  kubeconfig {
+    raw = file("path/to/admin/kubeconfig")
+  }
+
+  bootstrap_rbac {}
+
+  # Rotate the ServiceAccount token every 90 days
+  rotation_triggers = {
+    rotation = time_rotating.argocd_manager.id
+  }
+}
+
+resource "time_rotating" "argocd_manager" {
+  rotation_days = 90
+}
+
 ## GCP GKE cluster
 data "google_container_cluster" "cluster" {
   name     = "cluster"
@@ -101,3 +135,38 @@ resource "argocd_cluster" "eks" {
     }
   }
 }
+
+## GCP GKE cluster with Workload Identity
+resource "argocd_cluster" "gke_workload_identity" {
+  server = format("https://%s", data.google_container_cluster.cluster.endpoint)
+  name   = "gke-workload-identity"
+
+  config {
+    gcp_auth_config {}
+
+    tls_client_config {
+      ca_data = base64decode(data.google_container_cluster.cluster.master_auth.0.cluster_ca_certificate)
+    }
+  }
+}
+
+## Azure AKS cluster with workload identity
+data "azurerm_kubernetes_cluster" "cluster" {
+  name                = "cluster"
+  resource_group_name = "cluster-rg"
+}
+
+resource "argocd_cluster" "aks" {
+  server = data.azurerm_kubernetes_cluster.cluster.kube_config.0.host
+  name   = "aks"
+
+  config {
+    azure_auth_config {
+      client_id = "00000000-0000-0000-0000-000000000000"
+    }
+
+    tls_client_config {
+      ca_data = base64decode(data.azurerm_kubernetes_cluster.cluster.kube_config.0.cluster_ca_certificate)
+    }
+  }
+}
@@ -0,0 +1,3 @@
+# Project roles can be imported using the project name and role name separated by a colon.
+
+terraform import argocd_project_role.deployer myproject:deployer
@@ -0,0 +1,31 @@
+resource "argocd_project" "myproject" {
+  metadata {
+    name      = "myproject"
+    namespace = "argocd"
+  }
+
+  # Keep roles managed by argocd_project_role resources
+  ignore_external_roles = true
+
+  spec {
+    source_repos = ["*"]
+
+    destination {
+      server    = "https://kubernetes.default.svc"
+      namespace = "myproject"
+    }
+  }
+}
+
+resource "argocd_project_role" "deployer" {
+  project     = argocd_project.myproject.metadata[0].name
+  name        = "deployer"
+  description = "Allows the deploy team to sync applications"
+
+  policies = [
+    "p, proj:myproject:deployer, applications, get, myproject/*, allow",
+    "p, proj:myproject:deployer, applications, sync, myproject/*, allow",
+  ]
+
+  groups = ["deploy-team"]
+}
@@ -0,0 +1,4 @@
+# Sync windows can be imported using the project name, kind, schedule and duration separated by colons.
+# When several sync windows share these, append the index of the sync window among them, e.g. ':1'.
+
+terraform import argocd_project_sync_window.weekend_freeze 'myproject:deny:0 18 * * 5:62h'
@@ -0,0 +1,10 @@
+# Change freeze on weekends for all applications of the project
+resource "argocd_project_sync_window" "weekend_freeze" {
+  project      = "myproject"
+  kind         = "deny"
+  schedule     = "0 18 * * 5"
+  duration     = "62h"
+  timezone     = "Europe/Amsterdam"
+  applications = ["*"]
+  manual_sync  = true
+}
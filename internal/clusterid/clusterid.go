@@ -0,0 +1,35 @@
+package clusterid
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apiclient/cluster"
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+// ID returns the identifier of a cluster, either its server address or, when
+// its name differs from the server address, both separated by a "/".
+func ID(c *v1alpha1.Cluster) string {
+	if c.Name != "" && c.Name != c.Server {
+		return fmt.Sprintf("%s/%s", c.Server, c.Name)
+	}
+
+	return c.Server
+}
+
+// Query returns the query looking up the cluster with the given identifier. It
+// is the inverse of ID.
+func Query(id string) *cluster.ClusterQuery {
+	cq := &cluster.ClusterQuery{}
+
+	parts := strings.Split(strings.TrimPrefix(id, "https://"), "/")
+	if len(parts) > 1 {
+		cq.Name = parts[len(parts)-1]
+		cq.Server = fmt.Sprintf("https://%s", strings.Join(parts[:len(parts)-1], "/"))
+	} else {
+		cq.Server = id
+	}
+
+	return cq
+}
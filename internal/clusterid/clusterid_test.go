@@ -0,0 +1,27 @@
+package clusterid
+
+import (
+	"testing"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestQuery(t *testing.T) {
+	t.Parallel()
+
+	for _, c := range []*v1alpha1.Cluster{
+		{Server: "https://kubernetes.default.svc"},
+		{Server: "https://kubernetes.default.svc", Name: "https://kubernetes.default.svc"},
+		{Server: "https://kubernetes.default.svc", Name: "in-cluster"},
+		{Server: "https://example.com/k8s/clusters/c-1", Name: "rancher"},
+	} {
+		q := Query(ID(c))
+
+		assert.Equal(t, c.Server, q.Server)
+
+		if c.Name != c.Server {
+			assert.Equal(t, c.Name, q.Name)
+		}
+	}
+}
@@ -2,6 +2,7 @@ package diagnostics
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/argoproj-labs/terraform-provider-argocd/internal/features"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -15,6 +16,44 @@ func ArgoCDAPIError(action, resource, id string, err error) diag.Diagnostics {
 	return diags
 }
 
+// CreateError returns the diagnostics for an error returned by ArgoCD when
+// creating an object. ArgoCD accepts the creation of an object identical to an
+// existing one, and rejects it when the existing object differs unless the
+// upsert flag is set.
+func CreateError(resource, id string, err error) diag.Diagnostics {
+	if strings.Contains(err.Error(), "spec is different") {
+		return AlreadyExists(resource, id)
+	}
+
+	return ArgoCDAPIError("create", resource, id, err)
+}
+
+// AlreadyExists returns the error raised when an object to create already
+// exists with a different configuration and `adopt_existing` is not set.
+func AlreadyExists(resource, id string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	diags.AddError(
+		fmt.Sprintf("%s %s already exists", resource, id),
+		fmt.Sprintf("Set `adopt_existing = true` to take over the existing %s or import it using `terraform import`.", resource),
+	)
+
+	return diags
+}
+
+// Adopted returns the warning recording that an existing object has been taken
+// over because `adopt_existing` is set.
+func Adopted(resource, id string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	diags.AddWarning(
+		fmt.Sprintf("adopted existing %s %s", resource, id),
+		fmt.Sprintf("The %s already existed and has been updated to match the configuration.", resource),
+	)
+
+	return diags
+}
+
 func Error(summary string, err error) diag.Diagnostics {
 	var diags diag.Diagnostics
 
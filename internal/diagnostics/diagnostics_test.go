@@ -0,0 +1,19 @@
+package diagnostics
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateError(t *testing.T) {
+	t.Parallel()
+
+	diags := CreateError("project", "foo", errors.New("rpc error: code = InvalidArgument desc = existing project spec is different; use upsert flag to force update"))
+	assert.Equal(t, AlreadyExists("project", "foo"), diags)
+	assert.Equal(t, "project foo already exists", diags[0].Summary())
+
+	diags = CreateError("project", "foo", errors.New("rpc error: code = PermissionDenied desc = permission denied"))
+	assert.Equal(t, "failed to create project foo", diags[0].Summary())
+}
@@ -27,24 +27,26 @@ const (
 type FeatureConstraint struct {
 	// Name is a human-readable name for the feature.
 	Name string
+	// Key is a stable, machine-readable identifier for the feature.
+	Key string
 	// MinVersion is the minimum ArgoCD version that supports this feature.
 	MinVersion *semver.Version
 }
 
 var ConstraintsMap = map[Feature]FeatureConstraint{
-	ExecLogsPolicy:                             {"exec/logs RBAC policy", semver.MustParse("2.4.4")},
-	ProjectSourceNamespaces:                    {"project source namespaces", semver.MustParse("2.5.0")},
-	MultipleApplicationSources:                 {"multiple application sources", semver.MustParse("2.6.3")}, // Whilst the feature was introduced in 2.6.0 there was a bug that affects refresh of applications (and hence `wait` within this provider) that was only fixed in https://github.com/argoproj/argo-cd/pull/12576
-	ApplicationSet:                             {"application sets", semver.MustParse("2.5.0")},
-	ApplicationSetProgressiveSync:              {"progressive sync (`strategy`)", semver.MustParse("2.6.0")},
-	ManagedNamespaceMetadata:                   {"managed namespace metadata", semver.MustParse("2.6.0")},
-	ApplicationSetApplicationsSyncPolicy:       {"application set level application sync policy", semver.MustParse("2.8.0")},
-	ApplicationSetIgnoreApplicationDifferences: {"application set ignore application differences", semver.MustParse("2.9.0")},
-	ApplicationSetTemplatePatch:                {"application set template patch", semver.MustParse("2.10.0")},
-	ApplicationKustomizePatches:                {"application kustomize patches", semver.MustParse("2.9.0")},
-	ProjectFineGrainedPolicy:                   {"fine-grained policy in project", semver.MustParse("2.12.0")},
-	ApplicationSourceName:                      {"named application sources", semver.MustParse("2.14.0")},
-	ProjectDestinationServiceAccounts:          {"project destination service accounts", semver.MustParse("2.13.0")},
-	RepositoryDepth:                            {"repository shallow clone depth", semver.MustParse("3.3.0")},
-	ProjectClusterResourceName:                 {"project cluster resource name restriction", semver.MustParse("3.3.0")},
+	ExecLogsPolicy:                             {"exec/logs RBAC policy", "exec_logs_policy", semver.MustParse("2.4.4")},
+	ProjectSourceNamespaces:                    {"project source namespaces", "project_source_namespaces", semver.MustParse("2.5.0")},
+	MultipleApplicationSources:                 {"multiple application sources", "multiple_application_sources", semver.MustParse("2.6.3")}, // Whilst the feature was introduced in 2.6.0 there was a bug that affects refresh of applications (and hence `wait` within this provider) that was only fixed in https://github.com/argoproj/argo-cd/pull/12576
+	ApplicationSet:                             {"application sets", "application_set", semver.MustParse("2.5.0")},
+	ApplicationSetProgressiveSync:              {"progressive sync (`strategy`)", "application_set_progressive_sync", semver.MustParse("2.6.0")},
+	ManagedNamespaceMetadata:                   {"managed namespace metadata", "managed_namespace_metadata", semver.MustParse("2.6.0")},
+	ApplicationSetApplicationsSyncPolicy:       {"application set level application sync policy", "application_set_applications_sync_policy", semver.MustParse("2.8.0")},
+	ApplicationSetIgnoreApplicationDifferences: {"application set ignore application differences", "application_set_ignore_application_differences", semver.MustParse("2.9.0")},
+	ApplicationSetTemplatePatch:                {"application set template patch", "application_set_template_patch", semver.MustParse("2.10.0")},
+	ApplicationKustomizePatches:                {"application kustomize patches", "application_kustomize_patches", semver.MustParse("2.9.0")},
+	ProjectFineGrainedPolicy:                   {"fine-grained policy in project", "project_fine_grained_policy", semver.MustParse("2.12.0")},
+	ApplicationSourceName:                      {"named application sources", "application_source_name", semver.MustParse("2.14.0")},
+	ProjectDestinationServiceAccounts:          {"project destination service accounts", "project_destination_service_accounts", semver.MustParse("2.13.0")},
+	RepositoryDepth:                            {"repository shallow clone depth", "repository_depth", semver.MustParse("3.3.0")},
+	ProjectClusterResourceName:                 {"project cluster resource name restriction", "project_cluster_resource_name", semver.MustParse("3.3.0")},
 }
@@ -0,0 +1,84 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/argoproj-labs/terraform-provider-argocd/internal/diagnostics"
+	"github.com/argoproj/argo-cd/v3/pkg/apiclient/account"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &accountDataSource{}
+
+func NewArgoCDAccountDataSource() datasource.DataSource {
+	return &accountDataSource{}
+}
+
+// accountDataSource defines the data source implementation.
+type accountDataSource struct {
+	si *ServerInterface
+}
+
+func (d *accountDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_account"
+}
+
+func (d *accountDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Reads an existing ArgoCD [local account](https://argo-cd.readthedocs.io/en/stable/operator-manual/user-management/#local-usersaccounts).",
+		Attributes:          accountSchemaAttributes(true),
+	}
+}
+
+func (d *accountDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	si, ok := req.ProviderData.(*ServerInterface)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Data",
+			fmt.Sprintf("Expected *ServerInterface, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.si = si
+}
+
+func (d *accountDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data accountModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	// Initialize API clients
+	resp.Diagnostics.Append(d.si.InitClients(ctx)...)
+
+	// Check for errors before proceeding
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name := data.Name.ValueString()
+
+	a, err := d.si.AccountClient.GetAccount(ctx, &account.GetAccountRequest{Name: name})
+	if err != nil {
+		resp.Diagnostics.Append(diagnostics.ArgoCDAPIError("read", "account", name, err)...)
+		return
+	}
+
+	data = newAccount(a)
+
+	tflog.Trace(ctx, fmt.Sprintf("read account %s", name))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,39 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccArgoCDAccountDataSource(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+data "argocd_account" "test" {
+	name = "test"
+}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.argocd_account.test", "id", "test"),
+					resource.TestCheckResourceAttr("data.argocd_account.test", "name", "test"),
+					resource.TestCheckResourceAttr("data.argocd_account.test", "enabled", "true"),
+					resource.TestCheckTypeSetElemAttr("data.argocd_account.test", "capabilities.*", "apiKey"),
+					resource.TestCheckResourceAttrSet("data.argocd_account.test", "tokens.#"),
+				),
+			},
+			{
+				Config: `
+data "argocd_account" "missing" {
+	name = "does-not-exist"
+}
+				`,
+				ExpectError: regexp.MustCompile("failed to read account does-not-exist"),
+			},
+		},
+	})
+}
@@ -0,0 +1,105 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/argoproj-labs/terraform-provider-argocd/internal/diagnostics"
+	"github.com/argoproj/argo-cd/v3/pkg/apiclient/account"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &accountsDataSource{}
+
+func NewArgoCDAccountsDataSource() datasource.DataSource {
+	return &accountsDataSource{}
+}
+
+// accountsDataSource defines the data source implementation.
+type accountsDataSource struct {
+	si *ServerInterface
+}
+
+type accountsModel struct {
+	ID       types.String   `tfsdk:"id"`
+	Accounts []accountModel `tfsdk:"accounts"`
+}
+
+func (d *accountsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_accounts"
+}
+
+func (d *accountsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists all ArgoCD [local accounts](https://argo-cd.readthedocs.io/en/stable/operator-manual/user-management/#local-usersaccounts).",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Accounts identifier",
+				Computed:            true,
+			},
+			"accounts": schema.ListNestedAttribute{
+				MarkdownDescription: "Local accounts configured in ArgoCD.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: accountSchemaAttributes(false),
+				},
+			},
+		},
+	}
+}
+
+func (d *accountsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	si, ok := req.ProviderData.(*ServerInterface)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Data",
+			fmt.Sprintf("Expected *ServerInterface, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.si = si
+}
+
+func (d *accountsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data accountsModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	// Initialize API clients
+	resp.Diagnostics.Append(d.si.InitClients(ctx)...)
+
+	// Check for errors before proceeding
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	accounts, err := d.si.AccountClient.ListAccounts(ctx, &account.ListAccountRequest{})
+	if err != nil {
+		resp.Diagnostics.Append(diagnostics.Error("failed to list accounts", err)...)
+		return
+	}
+
+	data.ID = types.StringValue("accounts")
+	data.Accounts = make([]accountModel, len(accounts.Items))
+
+	for i, a := range accounts.Items {
+		data.Accounts[i] = newAccount(a)
+	}
+
+	tflog.Trace(ctx, "read accounts")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,33 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccArgoCDAccountsDataSource(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+data "argocd_accounts" "all" {}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.argocd_accounts.all", "id", "accounts"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.argocd_accounts.all", "accounts.*", map[string]string{
+						"name":    "admin",
+						"enabled": "true",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs("data.argocd_accounts.all", "accounts.*", map[string]string{
+						"name":           "test",
+						"enabled":        "true",
+						"capabilities.0": "apiKey",
+					}),
+				),
+			},
+		},
+	})
+}
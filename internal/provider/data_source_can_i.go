@@ -0,0 +1,119 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/argoproj-labs/terraform-provider-argocd/internal/diagnostics"
+	"github.com/argoproj/argo-cd/v3/pkg/apiclient/account"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &canIDataSource{}
+
+func NewArgoCDCanIDataSource() datasource.DataSource {
+	return &canIDataSource{}
+}
+
+// canIDataSource defines the data source implementation.
+type canIDataSource struct {
+	si *ServerInterface
+}
+
+type canIModel struct {
+	ID          types.String `tfsdk:"id"`
+	Resource    types.String `tfsdk:"resource"`
+	Action      types.String `tfsdk:"action"`
+	Subresource types.String `tfsdk:"subresource"`
+	Allowed     types.Bool   `tfsdk:"allowed"`
+}
+
+func (d *canIDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_can_i"
+}
+
+func (d *canIDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Checks whether the identity the provider is authenticated as is allowed to perform an action, according to the ArgoCD [RBAC configuration](https://argo-cd.readthedocs.io/en/stable/operator-manual/rbac/).",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Permission check identifier",
+				Computed:            true,
+			},
+			"resource": schema.StringAttribute{
+				MarkdownDescription: "RBAC resource to check, e.g. `applications`, `clusters` or `projects`.",
+				Required:            true,
+			},
+			"action": schema.StringAttribute{
+				MarkdownDescription: "RBAC action to check, e.g. `get`, `create`, `sync` or `delete`.",
+				Required:            true,
+			},
+			"subresource": schema.StringAttribute{
+				MarkdownDescription: "Object to check the action against, e.g. `my-project/my-app` for applications. Wildcards such as `*` are supported.",
+				Required:            true,
+			},
+			"allowed": schema.BoolAttribute{
+				MarkdownDescription: "Whether the action is allowed.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *canIDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	si, ok := req.ProviderData.(*ServerInterface)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Data",
+			fmt.Sprintf("Expected *ServerInterface, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.si = si
+}
+
+func (d *canIDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data canIModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	// Initialize API clients
+	resp.Diagnostics.Append(d.si.InitClients(ctx)...)
+
+	// Check for errors before proceeding
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := fmt.Sprintf("%s/%s/%s", data.Resource.ValueString(), data.Action.ValueString(), data.Subresource.ValueString())
+
+	r, err := d.si.AccountClient.CanI(ctx, &account.CanIRequest{
+		Resource:    data.Resource.ValueString(),
+		Action:      data.Action.ValueString(),
+		Subresource: data.Subresource.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.Append(diagnostics.Error(fmt.Sprintf("failed to check permission %s", id), err)...)
+		return
+	}
+
+	data.ID = types.StringValue(id)
+	data.Allowed = types.BoolValue(r.Value == "yes")
+
+	tflog.Trace(ctx, fmt.Sprintf("checked permission %s", id))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
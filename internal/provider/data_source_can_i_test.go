@@ -0,0 +1,40 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccArgoCDCanIDataSource(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+data "argocd_can_i" "sync" {
+	resource    = "applications"
+	action      = "sync"
+	subresource = "default/*"
+}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.argocd_can_i.sync", "id", "applications/sync/default/*"),
+					resource.TestCheckResourceAttr("data.argocd_can_i.sync", "allowed", "true"),
+				),
+			},
+			{
+				Config: `
+data "argocd_can_i" "invalid" {
+	resource    = "applications"
+	action      = "fly"
+	subresource = "*"
+}
+				`,
+				ExpectError: regexp.MustCompile("failed to check permission applications/fly/\\*"),
+			},
+		},
+	})
+}
@@ -0,0 +1,115 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/argoproj-labs/terraform-provider-argocd/internal/clusterid"
+	"github.com/argoproj-labs/terraform-provider-argocd/internal/diagnostics"
+	"github.com/argoproj/argo-cd/v3/pkg/apiclient/cluster"
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource                     = &clusterDataSource{}
+	_ datasource.DataSourceWithConfigValidators = &clusterDataSource{}
+)
+
+func NewArgoCDClusterDataSource() datasource.DataSource {
+	return &clusterDataSource{}
+}
+
+// clusterDataSource defines the data source implementation.
+type clusterDataSource struct {
+	si *ServerInterface
+}
+
+func (d *clusterDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cluster"
+}
+
+func (d *clusterDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Reads an existing [cluster](https://argo-cd.readthedocs.io/en/stable/operator-manual/declarative-setup/#clusters) registered in ArgoCD, by identifier, server address or name.",
+		Attributes:          clusterSchemaAttributes(true),
+	}
+}
+
+func (d *clusterDataSource) ConfigValidators(ctx context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("id"),
+			path.MatchRoot("server"),
+			path.MatchRoot("name"),
+		),
+	}
+}
+
+func (d *clusterDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	si, ok := req.ProviderData.(*ServerInterface)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Data",
+			fmt.Sprintf("Expected *ServerInterface, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.si = si
+}
+
+func (d *clusterDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data clusterModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	// Initialize API clients
+	resp.Diagnostics.Append(d.si.InitClients(ctx)...)
+
+	// Check for errors before proceeding
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var (
+		id string
+		q  *cluster.ClusterQuery
+	)
+
+	switch {
+	case !data.ID.IsNull():
+		id = data.ID.ValueString()
+		q = clusterid.Query(id)
+	case !data.Server.IsNull():
+		id = data.Server.ValueString()
+		q = &cluster.ClusterQuery{Server: id}
+	default:
+		id = data.Name.ValueString()
+		q = &cluster.ClusterQuery{Name: id}
+	}
+
+	c, err := d.si.ClusterClient.Get(ctx, q)
+	if err != nil {
+		resp.Diagnostics.Append(diagnostics.ArgoCDAPIError("read", "cluster", id, err)...)
+		return
+	}
+
+	data = newCluster(c)
+
+	tflog.Trace(ctx, fmt.Sprintf("read cluster %s", data.ID.ValueString()))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
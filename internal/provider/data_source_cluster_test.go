@@ -0,0 +1,119 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccArgoCDClusterDataSource(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+data "argocd_cluster" "in_cluster" {
+  server = "https://kubernetes.default.svc"
+}
+
+data "argocd_cluster" "by_id" {
+  id = "https://kubernetes.default.svc/in-cluster"
+}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.argocd_cluster.in_cluster", "id", "https://kubernetes.default.svc/in-cluster"),
+					resource.TestCheckResourceAttr("data.argocd_cluster.in_cluster", "name", "in-cluster"),
+					resource.TestCheckResourceAttrSet("data.argocd_cluster.in_cluster", "info.connection_state.status"),
+					resource.TestCheckResourceAttrSet("data.argocd_cluster.in_cluster", "info.applications_count"),
+					resource.TestCheckResourceAttr("data.argocd_cluster.by_id", "server", "https://kubernetes.default.svc"),
+				),
+			},
+			{
+				Config: `
+data "argocd_clusters" "all" {}
+
+data "argocd_clusters" "none" {
+  labels = {
+    "does-not" = "exist"
+  }
+}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.argocd_clusters.all", "id", "clusters"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.argocd_clusters.all", "clusters.*", map[string]string{
+						"server": "https://kubernetes.default.svc",
+						"name":   "in-cluster",
+					}),
+					resource.TestCheckResourceAttr("data.argocd_clusters.none", "clusters.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestClustersModelMatches(t *testing.T) {
+	t.Parallel()
+
+	c := &v1alpha1.Cluster{
+		Project: "foo",
+		Labels:  map[string]string{"env": "prod", "region": "eu"},
+	}
+
+	tests := []struct {
+		name    string
+		model   clustersModel
+		matches bool
+	}{
+		{
+			name:    "no filters",
+			model:   clustersModel{Project: types.StringNull()},
+			matches: true,
+		},
+		{
+			name:    "project",
+			model:   clustersModel{Project: types.StringValue("foo")},
+			matches: true,
+		},
+		{
+			name:    "other project",
+			model:   clustersModel{Project: types.StringValue("bar")},
+			matches: false,
+		},
+		{
+			name: "subset of labels",
+			model: clustersModel{
+				Project: types.StringNull(),
+				Labels:  map[string]types.String{"env": types.StringValue("prod")},
+			},
+			matches: true,
+		},
+		{
+			name: "label value mismatch",
+			model: clustersModel{
+				Project: types.StringNull(),
+				Labels:  map[string]types.String{"env": types.StringValue("dev")},
+			},
+			matches: false,
+		},
+		{
+			name: "missing label",
+			model: clustersModel{
+				Project: types.StringNull(),
+				Labels:  map[string]types.String{"team": types.StringValue("a")},
+			},
+			matches: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.matches, tt.model.matches(c))
+		})
+	}
+}
@@ -0,0 +1,134 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/argoproj-labs/terraform-provider-argocd/internal/diagnostics"
+	"github.com/argoproj/argo-cd/v3/pkg/apiclient/cluster"
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &clustersDataSource{}
+
+func NewArgoCDClustersDataSource() datasource.DataSource {
+	return &clustersDataSource{}
+}
+
+// clustersDataSource defines the data source implementation.
+type clustersDataSource struct {
+	si *ServerInterface
+}
+
+type clustersModel struct {
+	ID       types.String            `tfsdk:"id"`
+	Project  types.String            `tfsdk:"project"`
+	Labels   map[string]types.String `tfsdk:"labels"`
+	Clusters []clusterModel          `tfsdk:"clusters"`
+}
+
+func (d *clustersDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_clusters"
+}
+
+func (d *clustersDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the [clusters](https://argo-cd.readthedocs.io/en/stable/operator-manual/declarative-setup/#clusters) registered in ArgoCD.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Clusters identifier",
+				Computed:            true,
+			},
+			"project": schema.StringAttribute{
+				MarkdownDescription: "Only list the clusters scoped to this project.",
+				Optional:            true,
+			},
+			"labels": schema.MapAttribute{
+				MarkdownDescription: "Only list the clusters having all of these labels.",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"clusters": schema.ListNestedAttribute{
+				MarkdownDescription: "Clusters registered in ArgoCD.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: clusterSchemaAttributes(false),
+				},
+			},
+		},
+	}
+}
+
+func (d *clustersDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	si, ok := req.ProviderData.(*ServerInterface)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Data",
+			fmt.Sprintf("Expected *ServerInterface, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.si = si
+}
+
+func (d *clustersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data clustersModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	// Initialize API clients
+	resp.Diagnostics.Append(d.si.InitClients(ctx)...)
+
+	// Check for errors before proceeding
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	clusters, err := d.si.ClusterClient.List(ctx, &cluster.ClusterQuery{})
+	if err != nil {
+		resp.Diagnostics.Append(diagnostics.Error("failed to list clusters", err)...)
+		return
+	}
+
+	data.ID = types.StringValue("clusters")
+	data.Clusters = make([]clusterModel, 0, len(clusters.Items))
+
+	for i := range clusters.Items {
+		if data.matches(&clusters.Items[i]) {
+			data.Clusters = append(data.Clusters, newCluster(&clusters.Items[i]))
+		}
+	}
+
+	tflog.Trace(ctx, "read clusters")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// matches returns whether the cluster satisfies the filters of the data source.
+func (m clustersModel) matches(c *v1alpha1.Cluster) bool {
+	if !m.Project.IsNull() && c.Project != m.Project.ValueString() {
+		return false
+	}
+
+	for k, v := range m.Labels {
+		if l, ok := c.Labels[k]; !ok || l != v.ValueString() {
+			return false
+		}
+	}
+
+	return true
+}
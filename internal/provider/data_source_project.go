@@ -0,0 +1,94 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/argoproj-labs/terraform-provider-argocd/internal/diagnostics"
+	"github.com/argoproj/argo-cd/v3/pkg/apiclient/project"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &projectDataSource{}
+
+func NewArgoCDProjectDataSource() datasource.DataSource {
+	return &projectDataSource{}
+}
+
+// projectDataSource defines the data source implementation.
+type projectDataSource struct {
+	si *ServerInterface
+}
+
+func (d *projectDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_project"
+}
+
+func (d *projectDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := projectDataSourceSchemaAttributes(true)
+	attributes["id"] = schema.StringAttribute{
+		MarkdownDescription: "Project identifier",
+		Computed:            true,
+	}
+
+	for k, v := range projectDetailsSchemaAttributes() {
+		attributes[k] = v
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Reads an existing ArgoCD [project](https://argo-cd.readthedocs.io/en/stable/user-guide/projects/), including the global projects, repositories and clusters associated with it.",
+		Attributes:          attributes,
+	}
+}
+
+func (d *projectDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	si, ok := req.ProviderData.(*ServerInterface)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Data",
+			fmt.Sprintf("Expected *ServerInterface, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.si = si
+}
+
+func (d *projectDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data projectDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	// Initialize API clients
+	resp.Diagnostics.Append(d.si.InitClients(ctx)...)
+
+	// Check for errors before proceeding
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name := data.Name.ValueString()
+
+	p, err := d.si.ProjectClient.GetDetailedProject(ctx, &project.ProjectQuery{Name: name})
+	if err != nil {
+		resp.Diagnostics.Append(diagnostics.ArgoCDAPIError("read", "project", name, err)...)
+		return
+	}
+
+	data = newProjectDataSource(p)
+
+	tflog.Trace(ctx, fmt.Sprintf("read project %s", name))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
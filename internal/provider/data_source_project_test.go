@@ -0,0 +1,87 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccArgoCDProjectDataSource(t *testing.T) {
+	name := acctest.RandomWithPrefix("test-acc")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccArgoCDProjectDataSource(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.argocd_project.foo", "id", name),
+					resource.TestCheckResourceAttr("data.argocd_project.foo", "metadata.namespace", "argocd"),
+					resource.TestCheckResourceAttr("data.argocd_project.foo", "metadata.labels.acceptance", "true"),
+					resource.TestCheckResourceAttr("data.argocd_project.foo", "spec.description", "data source project"),
+					resource.TestCheckResourceAttr("data.argocd_project.foo", "spec.source_repos.0", "*"),
+					resource.TestCheckResourceAttr("data.argocd_project.foo", "spec.destination.0.server", "https://kubernetes.default.svc"),
+					resource.TestCheckResourceAttr("data.argocd_project.foo", "spec.destination.0.namespace", "foo"),
+					resource.TestCheckResourceAttr("data.argocd_project.foo", "spec.role.0.name", "reader"),
+					resource.TestCheckResourceAttr("data.argocd_project.foo", "spec.role.0.policies.#", "1"),
+					resource.TestCheckResourceAttr("data.argocd_project.foo", "global_projects.#", "0"),
+					resource.TestCheckResourceAttrSet("data.argocd_project.foo", "repositories.#"),
+					resource.TestCheckResourceAttrSet("data.argocd_project.foo", "clusters.#"),
+				),
+			},
+			{
+				Config: testAccArgoCDProjectDataSource(name) + `
+data "argocd_projects" "all" {
+  depends_on = [argocd_project.foo]
+}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.argocd_projects.all", "id", "projects"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.argocd_projects.all", "projects.*", map[string]string{
+						"name": "default",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs("data.argocd_projects.all", "projects.*", map[string]string{
+						"name":             name,
+						"spec.description": "data source project",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccArgoCDProjectDataSource(name string) string {
+	return fmt.Sprintf(`
+resource "argocd_project" "foo" {
+  metadata {
+    name      = "%[1]s"
+    namespace = "argocd"
+    labels = {
+      acceptance = "true"
+    }
+  }
+
+  spec {
+    description  = "data source project"
+    source_repos = ["*"]
+
+    destination {
+      server    = "https://kubernetes.default.svc"
+      namespace = "foo"
+    }
+
+    role {
+      name     = "reader"
+      policies = ["p, proj:%[1]s:reader, applications, get, %[1]s/*, allow"]
+    }
+  }
+}
+
+data "argocd_project" "foo" {
+  name = argocd_project.foo.metadata[0].name
+}
+`, name)
+}
@@ -0,0 +1,105 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/argoproj-labs/terraform-provider-argocd/internal/diagnostics"
+	"github.com/argoproj/argo-cd/v3/pkg/apiclient/project"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &projectsDataSource{}
+
+func NewArgoCDProjectsDataSource() datasource.DataSource {
+	return &projectsDataSource{}
+}
+
+// projectsDataSource defines the data source implementation.
+type projectsDataSource struct {
+	si *ServerInterface
+}
+
+type projectsModel struct {
+	ID       types.String        `tfsdk:"id"`
+	Projects []projectsItemModel `tfsdk:"projects"`
+}
+
+func (d *projectsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_projects"
+}
+
+func (d *projectsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists all ArgoCD [projects](https://argo-cd.readthedocs.io/en/stable/user-guide/projects/).",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Projects identifier",
+				Computed:            true,
+			},
+			"projects": schema.ListNestedAttribute{
+				MarkdownDescription: "Projects configured in ArgoCD.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: projectDataSourceSchemaAttributes(false),
+				},
+			},
+		},
+	}
+}
+
+func (d *projectsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	si, ok := req.ProviderData.(*ServerInterface)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Data",
+			fmt.Sprintf("Expected *ServerInterface, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.si = si
+}
+
+func (d *projectsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data projectsModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	// Initialize API clients
+	resp.Diagnostics.Append(d.si.InitClients(ctx)...)
+
+	// Check for errors before proceeding
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projects, err := d.si.ProjectClient.List(ctx, &project.ProjectQuery{})
+	if err != nil {
+		resp.Diagnostics.Append(diagnostics.Error("failed to list projects", err)...)
+		return
+	}
+
+	data.ID = types.StringValue("projects")
+	data.Projects = make([]projectsItemModel, len(projects.Items))
+
+	for i := range projects.Items {
+		data.Projects[i] = newProjectsItem(&projects.Items[i])
+	}
+
+	tflog.Trace(ctx, "read projects")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,149 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/argoproj-labs/terraform-provider-argocd/internal/diagnostics"
+	"github.com/argoproj-labs/terraform-provider-argocd/internal/sync"
+	"github.com/argoproj/argo-cd/v3/pkg/apiclient/repository"
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &repositoriesDataSource{}
+
+func NewArgoCDRepositoriesDataSource() datasource.DataSource {
+	return &repositoriesDataSource{}
+}
+
+// repositoriesDataSource defines the data source implementation.
+type repositoriesDataSource struct {
+	si *ServerInterface
+}
+
+type repositoriesModel struct {
+	ID           types.String                `tfsdk:"id"`
+	Project      types.String                `tfsdk:"project"`
+	Type         types.String                `tfsdk:"type"`
+	ForceRefresh types.Bool                  `tfsdk:"force_refresh"`
+	Repositories []repositoryDataSourceModel `tfsdk:"repositories"`
+}
+
+func (d *repositoriesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_repositories"
+}
+
+func (d *repositoriesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the [repositories](https://argo-cd.readthedocs.io/en/stable/operator-manual/declarative-setup/#repositories) registered in ArgoCD.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Repositories identifier",
+				Computed:            true,
+			},
+			"project": schema.StringAttribute{
+				MarkdownDescription: "Only list the repositories scoped to this project.",
+				Optional:            true,
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "Only list the repositories of this type. Can be either `git`, `helm` or `oci`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("git", "helm", "oci"),
+				},
+			},
+			"force_refresh": schema.BoolAttribute{
+				MarkdownDescription: "Whether to refresh the connection state of every repository instead of returning the cached state. Defaults to `false`.",
+				Optional:            true,
+			},
+			"repositories": schema.ListNestedAttribute{
+				MarkdownDescription: "Repositories registered in ArgoCD.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: repositoryDataSourceSchemaAttributes(false),
+				},
+			},
+		},
+	}
+}
+
+func (d *repositoriesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	si, ok := req.ProviderData.(*ServerInterface)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Data",
+			fmt.Sprintf("Expected *ServerInterface, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.si = si
+}
+
+func (d *repositoriesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data repositoriesModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	// Initialize API clients
+	resp.Diagnostics.Append(d.si.InitClients(ctx)...)
+
+	// Check for errors before proceeding
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	sync.RepositoryMutex.RLock()
+	repos, err := d.si.RepositoryClient.List(ctx, &repository.RepoQuery{
+		AppProject:   data.Project.ValueString(),
+		ForceRefresh: data.ForceRefresh.ValueBool(),
+	})
+	sync.RepositoryMutex.RUnlock()
+
+	if err != nil {
+		resp.Diagnostics.Append(diagnostics.Error("failed to list repositories", err)...)
+		return
+	}
+
+	data.ID = types.StringValue("repositories")
+	data.Repositories = make([]repositoryDataSourceModel, 0, len(repos.Items))
+
+	for _, r := range repos.Items {
+		if data.matches(r) {
+			data.Repositories = append(data.Repositories, newRepositoryDataSource(r))
+		}
+	}
+
+	tflog.Trace(ctx, "read repositories")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// matches returns whether the repository satisfies the filters of the data
+// source.
+func (m repositoriesModel) matches(r *v1alpha1.Repository) bool {
+	if !m.Project.IsNull() && r.Project != m.Project.ValueString() {
+		return false
+	}
+
+	if !m.Type.IsNull() && repositoryType(r) != m.Type.ValueString() {
+		return false
+	}
+
+	return true
+}
@@ -0,0 +1,92 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/argoproj-labs/terraform-provider-argocd/internal/diagnostics"
+	"github.com/argoproj-labs/terraform-provider-argocd/internal/sync"
+	"github.com/argoproj/argo-cd/v3/pkg/apiclient/repository"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &repositoryDataSource{}
+
+func NewArgoCDRepositoryDataSource() datasource.DataSource {
+	return &repositoryDataSource{}
+}
+
+// repositoryDataSource defines the data source implementation.
+type repositoryDataSource struct {
+	si *ServerInterface
+}
+
+func (d *repositoryDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_repository"
+}
+
+func (d *repositoryDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Reads an existing [repository](https://argo-cd.readthedocs.io/en/stable/operator-manual/declarative-setup/#repositories) registered in ArgoCD. The connection state of the repository is refreshed on every read.",
+		Attributes:          repositoryDataSourceSchemaAttributes(true),
+	}
+}
+
+func (d *repositoryDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	si, ok := req.ProviderData.(*ServerInterface)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Data",
+			fmt.Sprintf("Expected *ServerInterface, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.si = si
+}
+
+func (d *repositoryDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data repositoryDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	// Initialize API clients
+	resp.Diagnostics.Append(d.si.InitClients(ctx)...)
+
+	// Check for errors before proceeding
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	repoURL := data.Repo.ValueString()
+
+	sync.RepositoryMutex.RLock()
+	r, err := d.si.RepositoryClient.Get(ctx, &repository.RepoQuery{
+		Repo:         repoURL,
+		AppProject:   data.Project.ValueString(),
+		ForceRefresh: true,
+	})
+	sync.RepositoryMutex.RUnlock()
+
+	if err != nil {
+		resp.Diagnostics.Append(diagnostics.ArgoCDAPIError("read", "repository", repoURL, err)...)
+		return
+	}
+
+	data = newRepositoryDataSource(r)
+
+	tflog.Trace(ctx, fmt.Sprintf("read repository %s", data.ID.ValueString()))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
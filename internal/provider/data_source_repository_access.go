@@ -0,0 +1,232 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/argoproj-labs/terraform-provider-argocd/internal/diagnostics"
+	"github.com/argoproj/argo-cd/v3/pkg/apiclient/repository"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &repositoryAccessDataSource{}
+
+func NewArgoCDRepositoryAccessDataSource() datasource.DataSource {
+	return &repositoryAccessDataSource{}
+}
+
+// repositoryAccessDataSource defines the data source implementation.
+type repositoryAccessDataSource struct {
+	si *ServerInterface
+}
+
+type repositoryAccessModel struct {
+	ID                         types.String `tfsdk:"id"`
+	Repo                       types.String `tfsdk:"repo"`
+	Type                       types.String `tfsdk:"type"`
+	Name                       types.String `tfsdk:"name"`
+	Project                    types.String `tfsdk:"project"`
+	Username                   types.String `tfsdk:"username"`
+	Password                   types.String `tfsdk:"password"`
+	BearerToken                types.String `tfsdk:"bearer_token"`
+	SSHPrivateKey              types.String `tfsdk:"ssh_private_key"`
+	TLSClientCertData          types.String `tfsdk:"tls_client_cert_data"`
+	TLSClientCertKey           types.String `tfsdk:"tls_client_cert_key"`
+	GitHubAppID                types.String `tfsdk:"githubapp_id"`
+	GitHubAppInstallationID    types.String `tfsdk:"githubapp_installation_id"`
+	GitHubAppEnterpriseBaseURL types.String `tfsdk:"githubapp_enterprise_base_url"`
+	GitHubAppPrivateKey        types.String `tfsdk:"githubapp_private_key"`
+	Proxy                      types.String `tfsdk:"proxy"`
+	Insecure                   types.Bool   `tfsdk:"insecure"`
+	EnableOCI                  types.Bool   `tfsdk:"enable_oci"`
+	UseAzureWorkloadIdentity   types.Bool   `tfsdk:"use_azure_workload_identity"`
+}
+
+func (d *repositoryAccessDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_repository_access"
+}
+
+func (d *repositoryAccessDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Checks that ArgoCD can access a repository with the given credentials, without registering the repository. Reading the data source fails when the repository cannot be accessed, which allows testing credentials during a plan before creating an `argocd_repository`.\n\n~> **Note** The credentials are stored in the Terraform state, like the attributes of the `argocd_repository` resource.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "URL of the repository.",
+				Computed:            true,
+			},
+			"repo": schema.StringAttribute{
+				MarkdownDescription: "URL of the repository.",
+				Required:            true,
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "Type of the repo. Can be either `git`, `helm` or `oci`. `git` is assumed if empty or absent.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("git", "helm", "oci"),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the repo. Only used with Helm repos.",
+				Optional:            true,
+			},
+			"project": schema.StringAttribute{
+				MarkdownDescription: "The project name, in case the repository is going to be project scoped.",
+				Optional:            true,
+			},
+			"username": schema.StringAttribute{
+				MarkdownDescription: "Username used for authenticating at the remote repository.",
+				Optional:            true,
+			},
+			"password": schema.StringAttribute{
+				MarkdownDescription: "Password or PAT used for authenticating at the remote repository.",
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"bearer_token": schema.StringAttribute{
+				MarkdownDescription: "BearerToken contains the bearer token used for Git BitBucket Data Center auth at the repo server",
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"ssh_private_key": schema.StringAttribute{
+				MarkdownDescription: "PEM data for authenticating at the repo server. Only used with Git repos.",
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"tls_client_cert_data": schema.StringAttribute{
+				MarkdownDescription: "TLS client certificate in PEM format for authenticating at the repo server.",
+				Optional:            true,
+			},
+			"tls_client_cert_key": schema.StringAttribute{
+				MarkdownDescription: "TLS client certificate private key in PEM format for authenticating at the repo server.",
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"githubapp_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the GitHub app used to access the repo.",
+				Optional:            true,
+			},
+			"githubapp_installation_id": schema.StringAttribute{
+				MarkdownDescription: "The installation ID of the GitHub App used to access the repo.",
+				Optional:            true,
+			},
+			"githubapp_enterprise_base_url": schema.StringAttribute{
+				MarkdownDescription: "GitHub API URL for GitHub app authentication.",
+				Optional:            true,
+			},
+			"githubapp_private_key": schema.StringAttribute{
+				MarkdownDescription: "Private key data (PEM) for authentication via GitHub app.",
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"proxy": schema.StringAttribute{
+				MarkdownDescription: "HTTP/HTTPS proxy to access the repository.",
+				Optional:            true,
+			},
+			"insecure": schema.BoolAttribute{
+				MarkdownDescription: "Whether the connection to the repository ignores any errors when verifying TLS certificates or SSH host keys.",
+				Optional:            true,
+			},
+			"enable_oci": schema.BoolAttribute{
+				MarkdownDescription: "Whether `helm-oci` support should be enabled for this repository.",
+				Optional:            true,
+			},
+			"use_azure_workload_identity": schema.BoolAttribute{
+				MarkdownDescription: "Whether `Azure-Workload-identity` should be enabled for this repository.",
+				Optional:            true,
+			},
+		},
+	}
+}
+
+func (d *repositoryAccessDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	si, ok := req.ProviderData.(*ServerInterface)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Data",
+			fmt.Sprintf("Expected *ServerInterface, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.si = si
+}
+
+func (d *repositoryAccessDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data repositoryAccessModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	// Initialize API clients
+	resp.Diagnostics.Append(d.si.InitClients(ctx)...)
+
+	// Check for errors before proceeding
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	q := &repository.RepoAccessQuery{
+		Repo:                       data.Repo.ValueString(),
+		Type:                       data.Type.ValueString(),
+		Name:                       data.Name.ValueString(),
+		Project:                    data.Project.ValueString(),
+		Username:                   data.Username.ValueString(),
+		Password:                   data.Password.ValueString(),
+		BearerToken:                data.BearerToken.ValueString(),
+		SshPrivateKey:              data.SSHPrivateKey.ValueString(),
+		TlsClientCertData:          data.TLSClientCertData.ValueString(),
+		TlsClientCertKey:           data.TLSClientCertKey.ValueString(),
+		GithubAppEnterpriseBaseUrl: data.GitHubAppEnterpriseBaseURL.ValueString(),
+		GithubAppPrivateKey:        data.GitHubAppPrivateKey.ValueString(),
+		Proxy:                      data.Proxy.ValueString(),
+		Insecure:                   data.Insecure.ValueBool(),
+		EnableOci:                  data.EnableOCI.ValueBool(),
+		UseAzureWorkloadIdentity:   data.UseAzureWorkloadIdentity.ValueBool(),
+	}
+
+	if !data.GitHubAppID.IsNull() {
+		id, err := strconv.ParseInt(data.GitHubAppID.ValueString(), 10, 64)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("githubapp_id"), "Invalid GitHub App ID", err.Error())
+			return
+		}
+
+		q.GithubAppID = id
+	}
+
+	if !data.GitHubAppInstallationID.IsNull() {
+		id, err := strconv.ParseInt(data.GitHubAppInstallationID.ValueString(), 10, 64)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("githubapp_installation_id"), "Invalid GitHub App installation ID", err.Error())
+			return
+		}
+
+		q.GithubAppInstallationID = id
+	}
+
+	if _, err := d.si.RepositoryClient.ValidateAccess(ctx, q); err != nil {
+		resp.Diagnostics.Append(diagnostics.Error(fmt.Sprintf("failed to access repository %s", q.Repo), err)...)
+		return
+	}
+
+	data.ID = types.StringValue(q.Repo)
+
+	tflog.Trace(ctx, fmt.Sprintf("validated access to repository %s", q.Repo))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
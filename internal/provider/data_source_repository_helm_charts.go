@@ -0,0 +1,179 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/argoproj-labs/terraform-provider-argocd/internal/diagnostics"
+	"github.com/argoproj-labs/terraform-provider-argocd/internal/sync"
+	"github.com/argoproj/argo-cd/v3/pkg/apiclient/repository"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &repositoryHelmChartsDataSource{}
+
+func NewArgoCDRepositoryHelmChartsDataSource() datasource.DataSource {
+	return &repositoryHelmChartsDataSource{}
+}
+
+// repositoryHelmChartsDataSource defines the data source implementation.
+type repositoryHelmChartsDataSource struct {
+	si *ServerInterface
+}
+
+type repositoryHelmChartsModel struct {
+	ID                types.String     `tfsdk:"id"`
+	Repo              types.String     `tfsdk:"repo"`
+	Project           types.String     `tfsdk:"project"`
+	Chart             types.String     `tfsdk:"chart"`
+	VersionConstraint types.String     `tfsdk:"version_constraint"`
+	Charts            []helmChartModel `tfsdk:"charts"`
+}
+
+type helmChartModel struct {
+	Name          types.String   `tfsdk:"name"`
+	Versions      []types.String `tfsdk:"versions"`
+	LatestVersion types.String   `tfsdk:"latest_version"`
+}
+
+func (d *repositoryHelmChartsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_repository_helm_charts"
+}
+
+func (d *repositoryHelmChartsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the charts of a Helm repository registered in ArgoCD, together with their versions.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "URL of the repository.",
+				Computed:            true,
+			},
+			"repo": schema.StringAttribute{
+				MarkdownDescription: "URL of the Helm repository. The repository must be registered in ArgoCD, e.g. with the `argocd_repository` resource.",
+				Required:            true,
+			},
+			"project": schema.StringAttribute{
+				MarkdownDescription: "Project to which the repository is scoped, if any.",
+				Optional:            true,
+			},
+			"chart": schema.StringAttribute{
+				MarkdownDescription: "Only list the chart with this name. `charts` is empty if the repository does not contain the chart.",
+				Optional:            true,
+			},
+			"version_constraint": schema.StringAttribute{
+				MarkdownDescription: "[Semantic version constraint](https://github.com/Masterminds/semver#checking-version-constraints), e.g. `~1.2`, that the `latest_version` of each chart must satisfy. Defaults to any version that is not a pre-release.",
+				Optional:            true,
+			},
+			"charts": schema.ListNestedAttribute{
+				MarkdownDescription: "Charts of the repository.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Name of the chart.",
+							Computed:            true,
+						},
+						"versions": schema.ListAttribute{
+							MarkdownDescription: "Available versions of the chart.",
+							Computed:            true,
+							ElementType:         types.StringType,
+						},
+						"latest_version": schema.StringAttribute{
+							MarkdownDescription: "Highest version of the chart satisfying `version_constraint`. Unset if no version satisfies the constraint.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *repositoryHelmChartsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	si, ok := req.ProviderData.(*ServerInterface)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Data",
+			fmt.Sprintf("Expected *ServerInterface, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.si = si
+}
+
+func (d *repositoryHelmChartsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data repositoryHelmChartsModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	// Initialize API clients
+	resp.Diagnostics.Append(d.si.InitClients(ctx)...)
+
+	// Check for errors before proceeding
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	constraint, diags := parseVersionConstraint(data.VersionConstraint)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	repoURL := data.Repo.ValueString()
+
+	sync.RepositoryMutex.RLock()
+	charts, err := d.si.RepositoryClient.GetHelmCharts(ctx, &repository.RepoQuery{
+		Repo:       repoURL,
+		AppProject: data.Project.ValueString(),
+	})
+	sync.RepositoryMutex.RUnlock()
+
+	if err != nil {
+		resp.Diagnostics.Append(diagnostics.ArgoCDAPIError("read", "Helm charts of repository", repoURL, err)...)
+		return
+	}
+
+	data.ID = types.StringValue(repoURL)
+	data.Charts = make([]helmChartModel, 0, len(charts.Items))
+
+	for _, c := range charts.Items {
+		if !data.Chart.IsNull() && c.Name != data.Chart.ValueString() {
+			continue
+		}
+
+		m := helmChartModel{
+			Name:          types.StringValue(c.Name),
+			Versions:      make([]types.String, len(c.Versions)),
+			LatestVersion: types.StringNull(),
+		}
+
+		for i, v := range c.Versions {
+			m.Versions[i] = types.StringValue(v)
+		}
+
+		if v := latestVersion(c.Versions, constraint); v != "" {
+			m.LatestVersion = types.StringValue(v)
+		}
+
+		data.Charts = append(data.Charts, m)
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("read Helm charts of repository %s", repoURL))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,199 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Masterminds/semver/v3"
+	"github.com/argoproj-labs/terraform-provider-argocd/internal/diagnostics"
+	"github.com/argoproj-labs/terraform-provider-argocd/internal/sync"
+	"github.com/argoproj/argo-cd/v3/pkg/apiclient/repository"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &repositoryRefsDataSource{}
+
+func NewArgoCDRepositoryRefsDataSource() datasource.DataSource {
+	return &repositoryRefsDataSource{}
+}
+
+// repositoryRefsDataSource defines the data source implementation.
+type repositoryRefsDataSource struct {
+	si *ServerInterface
+}
+
+type repositoryRefsModel struct {
+	ID                types.String   `tfsdk:"id"`
+	Repo              types.String   `tfsdk:"repo"`
+	Project           types.String   `tfsdk:"project"`
+	VersionConstraint types.String   `tfsdk:"version_constraint"`
+	Branches          []types.String `tfsdk:"branches"`
+	Tags              []types.String `tfsdk:"tags"`
+	LatestTag         types.String   `tfsdk:"latest_tag"`
+}
+
+func (d *repositoryRefsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_repository_refs"
+}
+
+func (d *repositoryRefsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the branches and tags of a Git repository registered in ArgoCD.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "URL of the repository.",
+				Computed:            true,
+			},
+			"repo": schema.StringAttribute{
+				MarkdownDescription: "URL of the repository. The repository must be registered in ArgoCD, e.g. with the `argocd_repository` resource, unless it is publicly accessible.",
+				Required:            true,
+			},
+			"project": schema.StringAttribute{
+				MarkdownDescription: "Project to which the repository is scoped, if any.",
+				Optional:            true,
+			},
+			"version_constraint": schema.StringAttribute{
+				MarkdownDescription: "[Semantic version constraint](https://github.com/Masterminds/semver#checking-version-constraints), e.g. `~1.2`, that `latest_tag` must satisfy. Defaults to any version that is not a pre-release.",
+				Optional:            true,
+			},
+			"branches": schema.ListAttribute{
+				MarkdownDescription: "Branches of the repository.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"tags": schema.ListAttribute{
+				MarkdownDescription: "Tags of the repository.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"latest_tag": schema.StringAttribute{
+				MarkdownDescription: "Tag with the highest semantic version satisfying `version_constraint`. Tags which are not semantic versions, optionally prefixed with `v`, are ignored. Unset if no tag satisfies the constraint.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *repositoryRefsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	si, ok := req.ProviderData.(*ServerInterface)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Data",
+			fmt.Sprintf("Expected *ServerInterface, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.si = si
+}
+
+func (d *repositoryRefsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data repositoryRefsModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	// Initialize API clients
+	resp.Diagnostics.Append(d.si.InitClients(ctx)...)
+
+	// Check for errors before proceeding
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	constraint, diags := parseVersionConstraint(data.VersionConstraint)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	repoURL := data.Repo.ValueString()
+
+	sync.RepositoryMutex.RLock()
+	refs, err := d.si.RepositoryClient.ListRefs(ctx, &repository.RepoQuery{
+		Repo:       repoURL,
+		AppProject: data.Project.ValueString(),
+	})
+	sync.RepositoryMutex.RUnlock()
+
+	if err != nil {
+		resp.Diagnostics.Append(diagnostics.ArgoCDAPIError("read", "refs of repository", repoURL, err)...)
+		return
+	}
+
+	data.ID = types.StringValue(repoURL)
+	data.Branches = make([]types.String, len(refs.Branches))
+	data.Tags = make([]types.String, len(refs.Tags))
+
+	for i, b := range refs.Branches {
+		data.Branches[i] = types.StringValue(b)
+	}
+
+	for i, t := range refs.Tags {
+		data.Tags[i] = types.StringValue(t)
+	}
+
+	data.LatestTag = types.StringNull()
+	if t := latestVersion(refs.Tags, constraint); t != "" {
+		data.LatestTag = types.StringValue(t)
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("read refs of repository %s", repoURL))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// parseVersionConstraint parses the `version_constraint` attribute, defaulting
+// to any version that is not a pre-release when it is not set.
+func parseVersionConstraint(v types.String) (*semver.Constraints, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	s := "*"
+	if !v.IsNull() {
+		s = v.ValueString()
+	}
+
+	c, err := semver.NewConstraint(s)
+	if err != nil {
+		diags.AddAttributeError(path.Root("version_constraint"), "Invalid version constraint", err.Error())
+	}
+
+	return c, diags
+}
+
+// latestVersion returns the highest of the versions satisfying the constraint,
+// in its original form. Versions which cannot be parsed are ignored.
+func latestVersion(versions []string, constraint *semver.Constraints) string {
+	var (
+		latest   *semver.Version
+		original string
+	)
+
+	for _, v := range versions {
+		sv, err := semver.NewVersion(v)
+		if err != nil || !constraint.Check(sv) {
+			continue
+		}
+
+		if latest == nil || sv.GreaterThan(latest) {
+			latest = sv
+			original = v
+		}
+	}
+
+	return original
+}
@@ -0,0 +1,131 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAccArgoCDRepositoryRefsDataSource(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "argocd_repository" "git" {
+  repo = "https://github.com/argoproj-labs/terraform-provider-argocd"
+}
+
+data "argocd_repository_refs" "git" {
+  repo               = argocd_repository.git.repo
+  version_constraint = ">= 6.0.0, < 7.0.0"
+}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckTypeSetElemAttr("data.argocd_repository_refs.git", "branches.*", "main"),
+					resource.TestCheckTypeSetElemAttr("data.argocd_repository_refs.git", "tags.*", "v6.0.0"),
+					resource.TestMatchResourceAttr("data.argocd_repository_refs.git", "latest_tag", regexp.MustCompile(`^v6\.`)),
+				),
+			},
+			{
+				Config: `
+data "argocd_repository_refs" "invalid" {
+  repo               = "https://github.com/argoproj-labs/terraform-provider-argocd"
+  version_constraint = "not a constraint"
+}
+				`,
+				ExpectError: regexp.MustCompile("Invalid version constraint"),
+			},
+		},
+	})
+}
+
+func TestAccArgoCDRepositoryHelmChartsDataSource(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "argocd_repository" "helm" {
+  repo = "https://helm.nginx.com/stable"
+  name = "nginx-stable"
+  type = "helm"
+}
+
+data "argocd_repository_helm_charts" "ingress" {
+  repo  = argocd_repository.helm.repo
+  chart = "nginx-ingress"
+}
+
+data "argocd_repository_helm_charts" "missing" {
+  repo  = argocd_repository.helm.repo
+  chart = "does-not-exist"
+}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.argocd_repository_helm_charts.ingress", "charts.#", "1"),
+					resource.TestCheckResourceAttr("data.argocd_repository_helm_charts.ingress", "charts.0.name", "nginx-ingress"),
+					resource.TestCheckResourceAttrSet("data.argocd_repository_helm_charts.ingress", "charts.0.latest_version"),
+					resource.TestCheckResourceAttr("data.argocd_repository_helm_charts.missing", "charts.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestLatestVersion(t *testing.T) {
+	t.Parallel()
+
+	versions := []string{"main", "v1.2.0", "v1.10.0", "1.9.0", "v2.0.0-rc.1", "v1.3.0"}
+
+	tests := []struct {
+		name       string
+		constraint types.String
+		expected   string
+	}{
+		{
+			name:       "default",
+			constraint: types.StringNull(),
+			expected:   "v1.10.0",
+		},
+		{
+			name:       "tilde",
+			constraint: types.StringValue("~1.2"),
+			expected:   "v1.2.0",
+		},
+		{
+			name:       "pre-release",
+			constraint: types.StringValue(">= 2.0.0-0"),
+			expected:   "v2.0.0-rc.1",
+		},
+		{
+			name:       "no match",
+			constraint: types.StringValue(">= 3"),
+			expected:   "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			c, diags := parseVersionConstraint(tt.constraint)
+			require.False(t, diags.HasError())
+
+			assert.Equal(t, tt.expected, latestVersion(versions, c))
+		})
+	}
+}
+
+func TestParseVersionConstraint_invalid(t *testing.T) {
+	t.Parallel()
+
+	_, diags := parseVersionConstraint(types.StringValue("not a constraint"))
+	assert.True(t, diags.HasError())
+}
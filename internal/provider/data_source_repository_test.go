@@ -0,0 +1,130 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccArgoCDRepositoryDataSource(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "argocd_repository" "helm" {
+  repo = "https://helm.nginx.com/stable"
+  name = "nginx-stable"
+  type = "helm"
+}
+
+data "argocd_repository" "helm" {
+  repo = argocd_repository.helm.repo
+}
+
+data "argocd_repositories" "helm" {
+  type = "helm"
+
+  depends_on = [argocd_repository.helm]
+}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.argocd_repository.helm", "id", "https://helm.nginx.com/stable"),
+					resource.TestCheckResourceAttr("data.argocd_repository.helm", "name", "nginx-stable"),
+					resource.TestCheckResourceAttr("data.argocd_repository.helm", "type", "helm"),
+					resource.TestCheckResourceAttr("data.argocd_repository.helm", "project", ""),
+					resource.TestCheckResourceAttr("data.argocd_repository.helm", "connection_state.status", "Successful"),
+					resource.TestCheckResourceAttr("data.argocd_repositories.helm", "id", "repositories"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.argocd_repositories.helm", "repositories.*", map[string]string{
+						"repo": "https://helm.nginx.com/stable",
+						"type": "helm",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccArgoCDRepositoryAccessDataSource(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+data "argocd_repository_access" "public" {
+  repo = "https://github.com/kubernetes-sigs/kustomize"
+}
+				`,
+				Check: resource.TestCheckResourceAttr("data.argocd_repository_access.public", "id", "https://github.com/kubernetes-sigs/kustomize"),
+			},
+			{
+				Config: `
+data "argocd_repository_access" "invalid" {
+  repo     = "https://github.com/argoproj-labs/terraform-provider-argocd-does-not-exist"
+  username = "foo"
+  password = "bar"
+}
+				`,
+				ExpectError: regexp.MustCompile("failed to access repository"),
+			},
+		},
+	})
+}
+
+func TestRepositoriesModelMatches(t *testing.T) {
+	t.Parallel()
+
+	r := &v1alpha1.Repository{
+		Repo:    "https://helm.nginx.com/stable",
+		Type:    "helm",
+		Project: "foo",
+	}
+
+	tests := []struct {
+		name    string
+		model   repositoriesModel
+		matches bool
+	}{
+		{
+			name:    "no filters",
+			model:   repositoriesModel{Project: types.StringNull(), Type: types.StringNull()},
+			matches: true,
+		},
+		{
+			name:    "project and type",
+			model:   repositoriesModel{Project: types.StringValue("foo"), Type: types.StringValue("helm")},
+			matches: true,
+		},
+		{
+			name:    "other project",
+			model:   repositoriesModel{Project: types.StringValue("bar"), Type: types.StringNull()},
+			matches: false,
+		},
+		{
+			name:    "other type",
+			model:   repositoriesModel{Project: types.StringNull(), Type: types.StringValue("git")},
+			matches: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.matches, tt.model.matches(r))
+		})
+	}
+}
+
+func TestRepositoryType(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "git", repositoryType(&v1alpha1.Repository{}))
+	assert.Equal(t, "oci", repositoryType(&v1alpha1.Repository{Type: "oci"}))
+}
@@ -0,0 +1,46 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apiclient/settings"
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccArgoCDSettingsDataSource(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+data "argocd_settings" "current" {}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.argocd_settings.current", "id", "settings"),
+					resource.TestCheckResourceAttrSet("data.argocd_settings.current", "app_label_key"),
+					resource.TestCheckResourceAttrSet("data.argocd_settings.current", "password_pattern"),
+					resource.TestCheckResourceAttr("data.argocd_settings.current", "oidc_configured", "false"),
+					resource.TestCheckResourceAttr("data.argocd_settings.current", "user_logins_disabled", "false"),
+					resource.TestCheckResourceAttrSet("data.argocd_settings.current", "exec_enabled"),
+				),
+			},
+		},
+	})
+}
+
+func TestNewSettings_sparseResourceOverrides(t *testing.T) {
+	t.Parallel()
+
+	m := newSettings(&settings.Settings{
+		ResourceOverrides: map[string]*v1alpha1.ResourceOverride{
+			"apps/Deployment": {HealthLua: "return {}"},
+			"batch/Job":       nil,
+		},
+	})
+
+	assert.Len(t, m.ResourceOverrides, 1)
+	assert.Equal(t, "return {}", m.ResourceOverrides["apps/Deployment"].HealthLua.ValueString())
+}
@@ -0,0 +1,204 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/argoproj-labs/terraform-provider-argocd/internal/diagnostics"
+	"github.com/argoproj/argo-cd/v3/pkg/apiclient/application"
+	"github.com/argoproj/argo-cd/v3/pkg/apiclient/project"
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource                     = &syncWindowStateDataSource{}
+	_ datasource.DataSourceWithConfigValidators = &syncWindowStateDataSource{}
+)
+
+func NewArgoCDSyncWindowStateDataSource() datasource.DataSource {
+	return &syncWindowStateDataSource{}
+}
+
+// syncWindowStateDataSource defines the data source implementation.
+type syncWindowStateDataSource struct {
+	si *ServerInterface
+}
+
+type syncWindowStateModel struct {
+	ID                   types.String            `tfsdk:"id"`
+	Project              types.String            `tfsdk:"project"`
+	Application          types.String            `tfsdk:"application"`
+	ApplicationNamespace types.String            `tfsdk:"application_namespace"`
+	ActiveWindows        []activeSyncWindowModel `tfsdk:"active_windows"`
+	CanSync              types.Bool              `tfsdk:"can_sync"`
+}
+
+type activeSyncWindowModel struct {
+	Kind       types.String `tfsdk:"kind"`
+	Schedule   types.String `tfsdk:"schedule"`
+	Duration   types.String `tfsdk:"duration"`
+	ManualSync types.Bool   `tfsdk:"manual_sync"`
+}
+
+func (d *syncWindowStateDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_sync_window_state"
+}
+
+func (d *syncWindowStateDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Reads the [sync windows](https://argo-cd.readthedocs.io/en/stable/user-guide/sync_windows/) that are currently active for a project or an application.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Sync window state identifier",
+				Computed:            true,
+			},
+			"project": schema.StringAttribute{
+				MarkdownDescription: "Name of the project. When `application` is set, the project the application belongs to.",
+				Optional:            true,
+			},
+			"application": schema.StringAttribute{
+				MarkdownDescription: "Name of the application. Only the windows matching the application are returned.",
+				Optional:            true,
+			},
+			"application_namespace": schema.StringAttribute{
+				MarkdownDescription: "Namespace of the application.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("application")),
+				},
+			},
+			"active_windows": schema.ListNestedAttribute{
+				MarkdownDescription: "Sync windows that are currently active.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"kind": schema.StringAttribute{
+							MarkdownDescription: "Whether the window allows or blocks syncs, either `allow` or `deny`.",
+							Computed:            true,
+						},
+						"schedule": schema.StringAttribute{
+							MarkdownDescription: "Time the window begins, in cron format.",
+							Computed:            true,
+						},
+						"duration": schema.StringAttribute{
+							MarkdownDescription: "Amount of time the window is open.",
+							Computed:            true,
+						},
+						"manual_sync": schema.BoolAttribute{
+							MarkdownDescription: "Whether manual syncs are allowed when they would otherwise be blocked.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"can_sync": schema.BoolAttribute{
+				MarkdownDescription: "Whether the application can currently be synced manually, e.g. via the `sync` attribute of `argocd_application`. Only set when `application` is specified.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *syncWindowStateDataSource) ConfigValidators(ctx context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.AtLeastOneOf(
+			path.MatchRoot("project"),
+			path.MatchRoot("application"),
+		),
+	}
+}
+
+func (d *syncWindowStateDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	si, ok := req.ProviderData.(*ServerInterface)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Data",
+			fmt.Sprintf("Expected *ServerInterface, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.si = si
+}
+
+func (d *syncWindowStateDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data syncWindowStateModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	// Initialize API clients
+	resp.Diagnostics.Append(d.si.InitClients(ctx)...)
+
+	// Check for errors before proceeding
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Application.IsNull() {
+		projectName := data.Project.ValueString()
+
+		sw, err := d.si.ProjectClient.GetSyncWindowsState(ctx, &project.SyncWindowsQuery{Name: projectName})
+		if err != nil {
+			resp.Diagnostics.Append(diagnostics.ArgoCDAPIError("read", "sync windows of project", projectName, err)...)
+			return
+		}
+
+		data.ID = types.StringValue(projectName)
+		data.CanSync = types.BoolNull()
+		data.ActiveWindows = make([]activeSyncWindowModel, len(sw.Windows))
+
+		for i, w := range sw.Windows {
+			data.ActiveWindows[i] = activeSyncWindowModel{
+				Kind:       types.StringValue(w.Kind),
+				Schedule:   types.StringValue(w.Schedule),
+				Duration:   types.StringValue(w.Duration),
+				ManualSync: types.BoolValue(w.ManualSync),
+			}
+		}
+	} else {
+		appName := data.Application.ValueString()
+
+		sw, err := d.si.ApplicationClient.GetApplicationSyncWindows(ctx, &application.ApplicationSyncWindowsQuery{
+			Name:         data.Application.ValueStringPointer(),
+			AppNamespace: data.ApplicationNamespace.ValueStringPointer(),
+			Project:      data.Project.ValueStringPointer(),
+		})
+		if err != nil {
+			resp.Diagnostics.Append(diagnostics.ArgoCDAPIError("read", "sync windows of application", appName, err)...)
+			return
+		}
+
+		data.ID = types.StringValue(fmt.Sprintf("%s:%s", appName, data.ApplicationNamespace.ValueString()))
+		data.CanSync = types.BoolPointerValue(sw.CanSync)
+		data.ActiveWindows = make([]activeSyncWindowModel, len(sw.ActiveWindows))
+
+		for i, w := range sw.ActiveWindows {
+			data.ActiveWindows[i] = activeSyncWindowModel{
+				Kind:       types.StringPointerValue(w.Kind),
+				Schedule:   types.StringPointerValue(w.Schedule),
+				Duration:   types.StringPointerValue(w.Duration),
+				ManualSync: types.BoolPointerValue(w.ManualSync),
+			}
+		}
+	}
+
+	tflog.Trace(ctx, "read sync window state")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
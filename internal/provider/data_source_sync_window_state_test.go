@@ -0,0 +1,112 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccArgoCDSyncWindowStateDataSource(t *testing.T) {
+	name := acctest.RandomWithPrefix("test-acc")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccArgoCDSyncWindowStateDataSource(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.argocd_sync_window_state.project", "id", name),
+					resource.TestCheckNoResourceAttr("data.argocd_sync_window_state.project", "can_sync"),
+					resource.TestCheckResourceAttr("data.argocd_sync_window_state.project", "active_windows.#", "1"),
+					resource.TestCheckResourceAttr("data.argocd_sync_window_state.project", "active_windows.0.kind", "deny"),
+					resource.TestCheckResourceAttr("data.argocd_sync_window_state.project", "active_windows.0.schedule", "* * * * *"),
+					resource.TestCheckResourceAttr("data.argocd_sync_window_state.project", "active_windows.0.duration", "1h"),
+					resource.TestCheckResourceAttr("data.argocd_sync_window_state.project", "active_windows.0.manual_sync", "false"),
+					resource.TestCheckResourceAttr("data.argocd_sync_window_state.application", "id", name+":argocd"),
+					resource.TestCheckResourceAttr("data.argocd_sync_window_state.application", "can_sync", "false"),
+					resource.TestCheckResourceAttr("data.argocd_sync_window_state.application", "active_windows.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccArgoCDSyncWindowStateDataSource_Invalid(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+data "argocd_sync_window_state" "invalid" {}
+				`,
+				ExpectError: regexp.MustCompile("At least one of these attributes must be configured"),
+			},
+		},
+	})
+}
+
+func testAccArgoCDSyncWindowStateDataSource(name string) string {
+	return fmt.Sprintf(`
+resource "argocd_project" "foo" {
+  metadata {
+    name      = "%[1]s"
+    namespace = "argocd"
+  }
+
+  spec {
+    source_repos = ["*"]
+
+    destination {
+      server    = "https://kubernetes.default.svc"
+      namespace = "*"
+    }
+
+    sync_window {
+      kind         = "deny"
+      applications = ["*"]
+      schedule     = "* * * * *"
+      duration     = "1h"
+      manual_sync  = false
+    }
+  }
+}
+
+resource "argocd_application" "foo" {
+  metadata {
+    name      = "%[1]s"
+    namespace = "argocd"
+  }
+
+  spec {
+    project = argocd_project.foo.metadata[0].name
+
+    destination {
+      server    = "https://kubernetes.default.svc"
+      namespace = "default"
+    }
+
+    source {
+      repo_url        = "https://kubernetes-sigs.github.io/descheduler"
+      chart           = "descheduler"
+      target_revision = "0.33.0"
+    }
+  }
+}
+
+data "argocd_sync_window_state" "project" {
+  project = argocd_project.foo.metadata[0].name
+}
+
+data "argocd_sync_window_state" "application" {
+  project     = argocd_project.foo.metadata[0].name
+  application = argocd_application.foo.metadata[0].name
+
+  application_namespace = "argocd"
+}
+`, name)
+}
@@ -0,0 +1,121 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/argoproj-labs/terraform-provider-argocd/internal/diagnostics"
+	"github.com/argoproj/argo-cd/v3/pkg/apiclient/session"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &userInfoDataSource{}
+
+func NewArgoCDUserInfoDataSource() datasource.DataSource {
+	return &userInfoDataSource{}
+}
+
+// userInfoDataSource defines the data source implementation.
+type userInfoDataSource struct {
+	si *ServerInterface
+}
+
+type userInfoModel struct {
+	ID       types.String   `tfsdk:"id"`
+	LoggedIn types.Bool     `tfsdk:"logged_in"`
+	Username types.String   `tfsdk:"username"`
+	Issuer   types.String   `tfsdk:"iss"`
+	Groups   []types.String `tfsdk:"groups"`
+}
+
+func (d *userInfoDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user_info"
+}
+
+func (d *userInfoDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Reads information about the identity the provider is authenticated as.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "User info identifier",
+				Computed:            true,
+			},
+			"logged_in": schema.BoolAttribute{
+				MarkdownDescription: "Whether the provider session is authenticated.",
+				Computed:            true,
+			},
+			"username": schema.StringAttribute{
+				MarkdownDescription: "Name of the authenticated user or, for project tokens, the token subject.",
+				Computed:            true,
+			},
+			"iss": schema.StringAttribute{
+				MarkdownDescription: "Issuer of the session token, e.g. `argocd` for local users or the OIDC issuer for SSO users.",
+				Computed:            true,
+			},
+			"groups": schema.ListAttribute{
+				MarkdownDescription: "Groups the authenticated user belongs to.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+		},
+	}
+}
+
+func (d *userInfoDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	si, ok := req.ProviderData.(*ServerInterface)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Data",
+			fmt.Sprintf("Expected *ServerInterface, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.si = si
+}
+
+func (d *userInfoDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data userInfoModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	// Initialize API clients
+	resp.Diagnostics.Append(d.si.InitClients(ctx)...)
+
+	// Check for errors before proceeding
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ui, err := d.si.SessionClient.GetUserInfo(ctx, &session.GetUserInfoRequest{})
+	if err != nil {
+		resp.Diagnostics.Append(diagnostics.Error("failed to read user info", err)...)
+		return
+	}
+
+	data.ID = types.StringValue(ui.Username)
+	data.LoggedIn = types.BoolValue(ui.LoggedIn)
+	data.Username = types.StringValue(ui.Username)
+	data.Issuer = types.StringValue(ui.Iss)
+	data.Groups = make([]types.String, len(ui.Groups))
+
+	for i, g := range ui.Groups {
+		data.Groups[i] = types.StringValue(g)
+	}
+
+	tflog.Trace(ctx, "read user info")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,27 @@
+package provider
+
+import (
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccArgoCDUserInfoDataSource(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+data "argocd_user_info" "current" {}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.argocd_user_info.current", "logged_in", "true"),
+					resource.TestCheckResourceAttr("data.argocd_user_info.current", "username", os.Getenv("ARGOCD_AUTH_USERNAME")),
+					resource.TestCheckResourceAttr("data.argocd_user_info.current", "iss", "argocd"),
+				),
+			},
+		},
+	})
+}
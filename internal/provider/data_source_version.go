@@ -0,0 +1,181 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/argoproj-labs/terraform-provider-argocd/internal/features"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &versionDataSource{}
+
+func NewArgoCDVersionDataSource() datasource.DataSource {
+	return &versionDataSource{}
+}
+
+// versionDataSource defines the data source implementation.
+type versionDataSource struct {
+	si *ServerInterface
+}
+
+type versionModel struct {
+	ID               types.String          `tfsdk:"id"`
+	Version          types.String          `tfsdk:"version"`
+	BuildDate        types.String          `tfsdk:"build_date"`
+	GitCommit        types.String          `tfsdk:"git_commit"`
+	GitTag           types.String          `tfsdk:"git_tag"`
+	GitTreeState     types.String          `tfsdk:"git_tree_state"`
+	GoVersion        types.String          `tfsdk:"go_version"`
+	Compiler         types.String          `tfsdk:"compiler"`
+	Platform         types.String          `tfsdk:"platform"`
+	KustomizeVersion types.String          `tfsdk:"kustomize_version"`
+	HelmVersion      types.String          `tfsdk:"helm_version"`
+	KubectlVersion   types.String          `tfsdk:"kubectl_version"`
+	JsonnetVersion   types.String          `tfsdk:"jsonnet_version"`
+	ExtraBuildInfo   types.String          `tfsdk:"extra_build_info"`
+	Features         map[string]types.Bool `tfsdk:"features"`
+}
+
+func (d *versionDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_version"
+}
+
+func (d *versionDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Reads version information of the ArgoCD server and the provider features it supports.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Version identifier",
+				Computed:            true,
+			},
+			"version": schema.StringAttribute{
+				MarkdownDescription: "Version of the ArgoCD server.",
+				Computed:            true,
+			},
+			"build_date": schema.StringAttribute{
+				MarkdownDescription: "Build date of the ArgoCD server.",
+				Computed:            true,
+			},
+			"git_commit": schema.StringAttribute{
+				MarkdownDescription: "Git commit the ArgoCD server was built from.",
+				Computed:            true,
+			},
+			"git_tag": schema.StringAttribute{
+				MarkdownDescription: "Git tag the ArgoCD server was built from.",
+				Computed:            true,
+			},
+			"git_tree_state": schema.StringAttribute{
+				MarkdownDescription: "State of the Git tree the ArgoCD server was built from.",
+				Computed:            true,
+			},
+			"go_version": schema.StringAttribute{
+				MarkdownDescription: "Go version used to build the ArgoCD server.",
+				Computed:            true,
+			},
+			"compiler": schema.StringAttribute{
+				MarkdownDescription: "Compiler used to build the ArgoCD server.",
+				Computed:            true,
+			},
+			"platform": schema.StringAttribute{
+				MarkdownDescription: "Platform the ArgoCD server was built for.",
+				Computed:            true,
+			},
+			"kustomize_version": schema.StringAttribute{
+				MarkdownDescription: "Version of the bundled Kustomize.",
+				Computed:            true,
+			},
+			"helm_version": schema.StringAttribute{
+				MarkdownDescription: "Version of the bundled Helm.",
+				Computed:            true,
+			},
+			"kubectl_version": schema.StringAttribute{
+				MarkdownDescription: "Version of the bundled kubectl.",
+				Computed:            true,
+			},
+			"jsonnet_version": schema.StringAttribute{
+				MarkdownDescription: "Version of the bundled Jsonnet.",
+				Computed:            true,
+			},
+			"extra_build_info": schema.StringAttribute{
+				MarkdownDescription: "Additional build information.",
+				Computed:            true,
+			},
+			"features": schema.MapAttribute{
+				MarkdownDescription: "Whether each version dependent feature of the provider is supported by the ArgoCD server, keyed by feature, e.g. `multiple_application_sources`.",
+				Computed:            true,
+				ElementType:         types.BoolType,
+			},
+		},
+	}
+}
+
+func (d *versionDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	si, ok := req.ProviderData.(*ServerInterface)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Data",
+			fmt.Sprintf("Expected *ServerInterface, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.si = si
+}
+
+func (d *versionDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	// Initialize API clients
+	resp.Diagnostics.Append(d.si.InitClients(ctx)...)
+
+	// Check for errors before proceeding
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	vm := d.si.ServerVersionMessage
+
+	data := versionModel{
+		ID:               types.StringValue(vm.Version),
+		Version:          types.StringValue(vm.Version),
+		BuildDate:        types.StringValue(vm.BuildDate),
+		GitCommit:        types.StringValue(vm.GitCommit),
+		GitTag:           types.StringValue(vm.GitTag),
+		GitTreeState:     types.StringValue(vm.GitTreeState),
+		GoVersion:        types.StringValue(vm.GoVersion),
+		Compiler:         types.StringValue(vm.Compiler),
+		Platform:         types.StringValue(vm.Platform),
+		KustomizeVersion: types.StringValue(vm.KustomizeVersion),
+		HelmVersion:      types.StringValue(vm.HelmVersion),
+		KubectlVersion:   types.StringValue(vm.KubectlVersion),
+		JsonnetVersion:   types.StringValue(vm.JsonnetVersion),
+		ExtraBuildInfo:   types.StringValue(vm.ExtraBuildInfo),
+		Features:         supportedFeatures(d.si),
+	}
+
+	tflog.Trace(ctx, "read version")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// supportedFeatures returns whether each feature is supported by the server,
+// keyed by feature key.
+func supportedFeatures(si *ServerInterface) map[string]types.Bool {
+	m := make(map[string]types.Bool, len(features.ConstraintsMap))
+
+	for f, fc := range features.ConstraintsMap {
+		m[fc.Key] = types.BoolValue(si.IsFeatureSupported(f))
+	}
+
+	return m
+}
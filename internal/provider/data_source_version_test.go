@@ -0,0 +1,53 @@
+package provider
+
+import (
+	"os"
+	"regexp"
+	"strconv"
+	"testing"
+
+	"github.com/argoproj-labs/terraform-provider-argocd/internal/features"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccArgoCDVersionDataSource(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t); testAccPreCheckFeatureSupported(t, features.MultipleApplicationSources) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+data "argocd_version" "current" {}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr("data.argocd_version.current", "version", regexp.MustCompile("^v?"+regexp.QuoteMeta(os.Getenv("ARGOCD_VERSION")))),
+					resource.TestCheckResourceAttrSet("data.argocd_version.current", "helm_version"),
+					resource.TestCheckResourceAttrSet("data.argocd_version.current", "kustomize_version"),
+					resource.TestCheckResourceAttr("data.argocd_version.current", "features.%", strconv.Itoa(len(features.ConstraintsMap))),
+					resource.TestCheckResourceAttr("data.argocd_version.current", "features.multiple_application_sources", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestSupportedFeatures(t *testing.T) {
+	t.Parallel()
+
+	si := serverInterfaceTestData(t, "2.9.0", semverEquals)
+
+	got := supportedFeatures(si)
+
+	assert.Len(t, got, len(features.ConstraintsMap))
+	assert.Equal(t, types.BoolValue(true), got["application_kustomize_patches"])
+	assert.Equal(t, types.BoolValue(false), got["application_set_template_patch"])
+
+	keys := make(map[string]bool)
+	for _, fc := range features.ConstraintsMap {
+		assert.NotEmpty(t, fc.Key)
+		assert.False(t, keys[fc.Key], "duplicate feature key %s", fc.Key)
+		keys[fc.Key] = true
+	}
+}
@@ -0,0 +1,94 @@
+package provider
+
+import (
+	"strconv"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apiclient/account"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type accountModel struct {
+	ID           types.String        `tfsdk:"id"`
+	Name         types.String        `tfsdk:"name"`
+	Enabled      types.Bool          `tfsdk:"enabled"`
+	Capabilities []types.String      `tfsdk:"capabilities"`
+	Tokens       []accountTokenModel `tfsdk:"tokens"`
+}
+
+type accountTokenModel struct {
+	ID        types.String `tfsdk:"id"`
+	IssuedAt  types.String `tfsdk:"issued_at"`
+	ExpiresAt types.String `tfsdk:"expires_at"`
+}
+
+func accountSchemaAttributes(nameRequired bool) map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			MarkdownDescription: "Account identifier",
+			Computed:            true,
+		},
+		"name": schema.StringAttribute{
+			MarkdownDescription: "Name of the account.",
+			Required:            nameRequired,
+			Computed:            !nameRequired,
+		},
+		"enabled": schema.BoolAttribute{
+			MarkdownDescription: "Whether the account is enabled.",
+			Computed:            true,
+		},
+		"capabilities": schema.ListAttribute{
+			MarkdownDescription: "Capabilities of the account, e.g. `apiKey` or `login`.",
+			Computed:            true,
+			ElementType:         types.StringType,
+		},
+		"tokens": schema.ListNestedAttribute{
+			MarkdownDescription: "Tokens issued for the account.",
+			Computed:            true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"id": schema.StringAttribute{
+						MarkdownDescription: "Token identifier.",
+						Computed:            true,
+					},
+					"issued_at": schema.StringAttribute{
+						MarkdownDescription: "Unix timestamp at which the token was issued.",
+						Computed:            true,
+					},
+					"expires_at": schema.StringAttribute{
+						MarkdownDescription: "Unix timestamp upon which the token will expire. Unset if the token does not expire.",
+						Computed:            true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func newAccount(a *account.Account) accountModel {
+	m := accountModel{
+		ID:      types.StringValue(a.Name),
+		Name:    types.StringValue(a.Name),
+		Enabled: types.BoolValue(a.Enabled),
+	}
+
+	m.Capabilities = make([]types.String, len(a.Capabilities))
+	for i, c := range a.Capabilities {
+		m.Capabilities[i] = types.StringValue(c)
+	}
+
+	m.Tokens = make([]accountTokenModel, len(a.Tokens))
+	for i, t := range a.Tokens {
+		m.Tokens[i] = accountTokenModel{
+			ID:        types.StringValue(t.Id),
+			IssuedAt:  types.StringValue(strconv.FormatInt(t.IssuedAt, 10)),
+			ExpiresAt: types.StringNull(),
+		}
+
+		if t.ExpiresAt > 0 {
+			m.Tokens[i].ExpiresAt = types.StringValue(strconv.FormatInt(t.ExpiresAt, 10))
+		}
+	}
+
+	return m
+}
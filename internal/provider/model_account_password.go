@@ -0,0 +1,58 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type accountPasswordModel struct {
+	ID                types.String `tfsdk:"id"`
+	Account           types.String `tfsdk:"account"`
+	PasswordWO        types.String `tfsdk:"password_wo"`
+	CurrentPasswordWO types.String `tfsdk:"current_password_wo"`
+	PasswordHash      types.String `tfsdk:"password_hash"`
+	RotationTriggers  types.Map    `tfsdk:"rotation_triggers"`
+}
+
+func accountPasswordSchemaAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Description: "Account password identifier",
+			Computed:    true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"account": schema.StringAttribute{
+			Description: "The name of the local account whose password is managed.",
+			Required:    true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"password_wo": schema.StringAttribute{
+			Description: "New password of the account. This value is write-only and never stored in the Terraform state.",
+			Required:    true,
+			Sensitive:   true,
+			WriteOnly:   true,
+		},
+		"current_password_wo": schema.StringAttribute{
+			Description: "Password of the user the provider is authenticated as, required by ArgoCD to authorize the change. Defaults to the provider `password`. This value is write-only and never stored in the Terraform state.",
+			Optional:    true,
+			Sensitive:   true,
+			WriteOnly:   true,
+		},
+		"password_hash": schema.StringAttribute{
+			Description: "Bcrypt hash of the password last set by this resource, used to detect changes to `password_wo`.",
+			Computed:    true,
+			Sensitive:   true,
+		},
+		"rotation_triggers": schema.MapAttribute{
+			Description: "Arbitrary map of values that, when changed, will set the password again. Useful to rotate a password generated by another resource.",
+			Optional:    true,
+			ElementType: types.StringType,
+		},
+	}
+}
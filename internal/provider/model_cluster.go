@@ -0,0 +1,137 @@
+package provider
+
+import (
+	"github.com/argoproj-labs/terraform-provider-argocd/internal/clusterid"
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type clusterModel struct {
+	ID          types.String            `tfsdk:"id"`
+	Server      types.String            `tfsdk:"server"`
+	Name        types.String            `tfsdk:"name"`
+	Project     types.String            `tfsdk:"project"`
+	Shard       types.Int64             `tfsdk:"shard"`
+	Namespaces  []types.String          `tfsdk:"namespaces"`
+	Labels      map[string]types.String `tfsdk:"labels"`
+	Annotations map[string]types.String `tfsdk:"annotations"`
+	Info        *clusterInfoModel       `tfsdk:"info"`
+}
+
+type clusterInfoModel struct {
+	ServerVersion     types.String                 `tfsdk:"server_version"`
+	ApplicationsCount types.Int64                  `tfsdk:"applications_count"`
+	ConnectionState   *clusterConnectionStateModel `tfsdk:"connection_state"`
+}
+
+type clusterConnectionStateModel struct {
+	Status  types.String `tfsdk:"status"`
+	Message types.String `tfsdk:"message"`
+}
+
+func clusterSchemaAttributes(lookup bool) map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			MarkdownDescription: "Cluster identifier, in the same format as the `argocd_cluster` resource identifier: the server address, followed by `/<name>` when the name differs from the server address.",
+			Optional:            lookup,
+			Computed:            true,
+		},
+		"server": schema.StringAttribute{
+			MarkdownDescription: "API server URL of the Kubernetes cluster.",
+			Optional:            lookup,
+			Computed:            true,
+		},
+		"name": schema.StringAttribute{
+			MarkdownDescription: "Name of the cluster.",
+			Optional:            lookup,
+			Computed:            true,
+		},
+		"project": schema.StringAttribute{
+			MarkdownDescription: "Project to which the cluster is scoped, if any.",
+			Computed:            true,
+		},
+		"shard": schema.Int64Attribute{
+			MarkdownDescription: "Shard of the application controller managing the cluster, if set.",
+			Computed:            true,
+		},
+		"namespaces": schema.ListAttribute{
+			MarkdownDescription: "Namespaces which are accessible in the cluster. Empty if all namespaces are accessible.",
+			Computed:            true,
+			ElementType:         types.StringType,
+		},
+		"labels": schema.MapAttribute{
+			MarkdownDescription: "Labels of the cluster secret.",
+			Computed:            true,
+			ElementType:         types.StringType,
+		},
+		"annotations": schema.MapAttribute{
+			MarkdownDescription: "Annotations of the cluster secret.",
+			Computed:            true,
+			ElementType:         types.StringType,
+		},
+		"info": schema.SingleNestedAttribute{
+			MarkdownDescription: "Information about cluster cache and state.",
+			Computed:            true,
+			Attributes: map[string]schema.Attribute{
+				"server_version": schema.StringAttribute{
+					MarkdownDescription: "Kubernetes version of the cluster.",
+					Computed:            true,
+				},
+				"applications_count": schema.Int64Attribute{
+					MarkdownDescription: "Number of applications managed by Argo CD on the cluster.",
+					Computed:            true,
+				},
+				"connection_state": schema.SingleNestedAttribute{
+					MarkdownDescription: "Information about the connection to the cluster.",
+					Computed:            true,
+					Attributes: map[string]schema.Attribute{
+						"status": schema.StringAttribute{
+							MarkdownDescription: "Current status indicator for the connection.",
+							Computed:            true,
+						},
+						"message": schema.StringAttribute{
+							MarkdownDescription: "Human readable information about the connection status.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func newCluster(c *v1alpha1.Cluster) clusterModel {
+	m := clusterModel{
+		ID:          types.StringValue(clusterid.ID(c)),
+		Server:      types.StringValue(c.Server),
+		Name:        types.StringValue(c.Name),
+		Project:     types.StringValue(c.Project),
+		Shard:       types.Int64PointerValue(c.Shard),
+		Namespaces:  make([]types.String, len(c.Namespaces)),
+		Labels:      make(map[string]types.String, len(c.Labels)),
+		Annotations: make(map[string]types.String, len(c.Annotations)),
+		Info: &clusterInfoModel{
+			ServerVersion:     types.StringValue(c.Info.ServerVersion),
+			ApplicationsCount: types.Int64Value(c.Info.ApplicationsCount),
+			ConnectionState: &clusterConnectionStateModel{
+				Status:  types.StringValue(c.Info.ConnectionState.Status),
+				Message: types.StringValue(c.Info.ConnectionState.Message),
+			},
+		},
+	}
+
+	for i, ns := range c.Namespaces {
+		m.Namespaces[i] = types.StringValue(ns)
+	}
+
+	for k, v := range c.Labels {
+		m.Labels[k] = types.StringValue(v)
+	}
+
+	for k, v := range c.Annotations {
+		m.Annotations[k] = types.StringValue(v)
+	}
+
+	return m
+}
@@ -11,9 +11,12 @@ import (
 )
 
 type projectModel struct {
-	ID       types.String       `tfsdk:"id"`
-	Metadata []objectMeta       `tfsdk:"metadata"`
-	Spec     []projectSpecModel `tfsdk:"spec"`
+	ID                        types.String       `tfsdk:"id"`
+	AdoptExisting             types.Bool         `tfsdk:"adopt_existing"`
+	IgnoreExternalRoles       types.Bool         `tfsdk:"ignore_external_roles"`
+	IgnoreExternalSyncWindows types.Bool         `tfsdk:"ignore_external_sync_windows"`
+	Metadata                  []objectMeta       `tfsdk:"metadata"`
+	Spec                      []projectSpecModel `tfsdk:"spec"`
 }
 
 type projectSpecModel struct {
@@ -92,6 +95,15 @@ type syncWindowModel struct {
 	UseAndOperator types.Bool     `tfsdk:"use_and_operator"`
 }
 
+// matches reports whether the given sync window has the kind, schedule,
+// duration and targets of the model.
+func (m syncWindowModel) matches(w *v1alpha1.SyncWindow) bool {
+	return w.Kind == m.Kind.ValueString() && w.Schedule == m.Schedule.ValueString() && w.Duration == m.Duration.ValueString() &&
+		equalStrings(w.Applications, m.Applications) &&
+		equalStrings(w.Clusters, m.Clusters) &&
+		equalStrings(w.Namespaces, m.Namespaces)
+}
+
 func projectSchemaBlocks() map[string]schema.Block {
 	return map[string]schema.Block{
 		"metadata": objectMetaSchemaListBlock("appproject"),
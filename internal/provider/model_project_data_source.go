@@ -0,0 +1,397 @@
+package provider
+
+import (
+	"github.com/argoproj/argo-cd/v3/pkg/apiclient/project"
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type projectDataSourceModel struct {
+	ID             types.String             `tfsdk:"id"`
+	Name           types.String             `tfsdk:"name"`
+	Metadata       *objectMeta              `tfsdk:"metadata"`
+	Spec           *projectSpecModel        `tfsdk:"spec"`
+	GlobalProjects []types.String           `tfsdk:"global_projects"`
+	Repositories   []projectRepositoryModel `tfsdk:"repositories"`
+	Clusters       []projectClusterModel    `tfsdk:"clusters"`
+}
+
+type projectsItemModel struct {
+	Name     types.String      `tfsdk:"name"`
+	Metadata *objectMeta       `tfsdk:"metadata"`
+	Spec     *projectSpecModel `tfsdk:"spec"`
+}
+
+type projectRepositoryModel struct {
+	Repo types.String `tfsdk:"repo"`
+	Name types.String `tfsdk:"name"`
+	Type types.String `tfsdk:"type"`
+}
+
+type projectClusterModel struct {
+	Server types.String `tfsdk:"server"`
+	Name   types.String `tfsdk:"name"`
+}
+
+func projectDataSourceSchemaAttributes(nameRequired bool) map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"name": schema.StringAttribute{
+			MarkdownDescription: "Name of the project.",
+			Required:            nameRequired,
+			Computed:            !nameRequired,
+		},
+		"metadata": projectMetadataSchemaAttribute(),
+		"spec":     projectSpecSchemaAttribute(),
+	}
+}
+
+func projectDetailsSchemaAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"global_projects": schema.ListAttribute{
+			MarkdownDescription: "Names of the [global projects](https://argo-cd.readthedocs.io/en/stable/user-guide/projects/#configuring-global-projects-v18) whose configuration is inherited by the project.",
+			Computed:            true,
+			ElementType:         types.StringType,
+		},
+		"repositories": schema.ListNestedAttribute{
+			MarkdownDescription: "Repositories scoped to the project.",
+			Computed:            true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"repo": schema.StringAttribute{
+						MarkdownDescription: "URL of the repository.",
+						Computed:            true,
+					},
+					"name": schema.StringAttribute{
+						MarkdownDescription: "Name of the repository.",
+						Computed:            true,
+					},
+					"type": schema.StringAttribute{
+						MarkdownDescription: "Type of the repository, either `git` or `helm`.",
+						Computed:            true,
+					},
+				},
+			},
+		},
+		"clusters": schema.ListNestedAttribute{
+			MarkdownDescription: "Clusters scoped to the project.",
+			Computed:            true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"server": schema.StringAttribute{
+						MarkdownDescription: "URL of the cluster's Kubernetes control plane API.",
+						Computed:            true,
+					},
+					"name": schema.StringAttribute{
+						MarkdownDescription: "Name of the cluster.",
+						Computed:            true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func projectMetadataSchemaAttribute() schema.Attribute {
+	return schema.SingleNestedAttribute{
+		MarkdownDescription: "Standard Kubernetes object metadata. For more info see the [Kubernetes reference](https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#metadata).",
+		Computed:            true,
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the appproject.",
+				Computed:            true,
+			},
+			"namespace": schema.StringAttribute{
+				MarkdownDescription: "Namespace of the appproject.",
+				Computed:            true,
+			},
+			"annotations": schema.MapAttribute{
+				MarkdownDescription: "An unstructured key value map stored with the appproject that may be used to store arbitrary metadata.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"labels": schema.MapAttribute{
+				MarkdownDescription: "Map of string keys and values that can be used to organize and categorize (scope and select) the appproject.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"generation": schema.Int64Attribute{
+				MarkdownDescription: "A sequence number representing a specific generation of the desired state.",
+				Computed:            true,
+			},
+			"resource_version": schema.StringAttribute{
+				MarkdownDescription: "An opaque value that represents the internal version of this appproject that can be used by clients to determine when the appproject has changed.",
+				Computed:            true,
+			},
+			"uid": schema.StringAttribute{
+				MarkdownDescription: "The unique in time and space value for this appproject.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func projectSpecSchemaAttribute() schema.Attribute {
+	return schema.SingleNestedAttribute{
+		MarkdownDescription: "ArgoCD AppProject spec.",
+		Computed:            true,
+		Attributes: map[string]schema.Attribute{
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Project description.",
+				Computed:            true,
+			},
+			"source_repos": schema.ListAttribute{
+				MarkdownDescription: "List of repositories from which applications may be created.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"source_namespaces": schema.ListAttribute{
+				MarkdownDescription: "List of source namespaces for applications.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"signature_keys": schema.ListAttribute{
+				MarkdownDescription: "Signature keys for verifying the integrity of applications.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"destination": schema.ListNestedAttribute{
+				MarkdownDescription: "Destinations available for deployment.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"server": schema.StringAttribute{
+							MarkdownDescription: "URL of the target cluster.",
+							Computed:            true,
+						},
+						"namespace": schema.StringAttribute{
+							MarkdownDescription: "Target namespace for applications' resources.",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Name of the destination cluster.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"destination_service_account": schema.ListNestedAttribute{
+				MarkdownDescription: "Service accounts to be impersonated for the application sync operation for each destination.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"default_service_account": schema.StringAttribute{
+							MarkdownDescription: "Used for impersonation during the sync operation.",
+							Computed:            true,
+						},
+						"namespace": schema.StringAttribute{
+							MarkdownDescription: "Target namespace for the application's resources.",
+							Computed:            true,
+						},
+						"server": schema.StringAttribute{
+							MarkdownDescription: "URL of the target cluster's Kubernetes control plane API.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"cluster_resource_blacklist":   projectClusterResourceRestrictionSchemaAttribute("Blacklisted cluster level resources."),
+			"cluster_resource_whitelist":   projectClusterResourceRestrictionSchemaAttribute("Whitelisted cluster level resources."),
+			"namespace_resource_blacklist": projectGroupKindSchemaAttribute("Blacklisted namespace level resources."),
+			"namespace_resource_whitelist": projectGroupKindSchemaAttribute("Whitelisted namespace level resources."),
+			"orphaned_resources": schema.ListNestedAttribute{
+				MarkdownDescription: "Configuration for orphaned resources tracking.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"warn": schema.BoolAttribute{
+							MarkdownDescription: "Whether a warning condition should be created for apps which have orphaned resources.",
+							Computed:            true,
+						},
+						"ignore": projectClusterResourceRestrictionSchemaAttribute("Resources to ignore during orphaned resources detection."),
+					},
+				},
+			},
+			"role": schema.ListNestedAttribute{
+				MarkdownDescription: "Project roles.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The name of the role.",
+							Computed:            true,
+						},
+						"description": schema.StringAttribute{
+							MarkdownDescription: "Description of the role.",
+							Computed:            true,
+						},
+						"policies": schema.ListAttribute{
+							MarkdownDescription: "Casbin formatted strings that define access policies for the role in the project.",
+							Computed:            true,
+							ElementType:         types.StringType,
+						},
+						"groups": schema.ListAttribute{
+							MarkdownDescription: "OIDC group claims bound to this role.",
+							Computed:            true,
+							ElementType:         types.StringType,
+						},
+						"jwt_tokens": schema.ListNestedAttribute{
+							MarkdownDescription: "Always empty. JWT tokens are exposed by the `argocd_project_token` resource.",
+							Computed:            true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"iat": schema.Int64Attribute{
+										MarkdownDescription: "Token issued at (timestamp).",
+										Computed:            true,
+									},
+									"id": schema.StringAttribute{
+										MarkdownDescription: "Token identifier.",
+										Computed:            true,
+									},
+									"exp": schema.Int64Attribute{
+										MarkdownDescription: "Token expiration (timestamp).",
+										Computed:            true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"sync_window": schema.ListNestedAttribute{
+				MarkdownDescription: "Controls when sync operations are allowed for the project.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"use_and_operator": schema.BoolAttribute{
+							MarkdownDescription: "Whether the AND operator is used among the various conditions for the sync window.",
+							Computed:            true,
+						},
+						"kind": schema.StringAttribute{
+							MarkdownDescription: "Whether the window allows or blocks syncs, either `allow` or `deny`.",
+							Computed:            true,
+						},
+						"applications": schema.ListAttribute{
+							MarkdownDescription: "Applications that the window applies to.",
+							Computed:            true,
+							ElementType:         types.StringType,
+						},
+						"namespaces": schema.ListAttribute{
+							MarkdownDescription: "Namespaces that the window applies to.",
+							Computed:            true,
+							ElementType:         types.StringType,
+						},
+						"clusters": schema.ListAttribute{
+							MarkdownDescription: "Clusters that the window applies to.",
+							Computed:            true,
+							ElementType:         types.StringType,
+						},
+						"manual_sync": schema.BoolAttribute{
+							MarkdownDescription: "Whether manual syncs are enabled when they would otherwise be blocked.",
+							Computed:            true,
+						},
+						"schedule": schema.StringAttribute{
+							MarkdownDescription: "Time the window begins, in cron format.",
+							Computed:            true,
+						},
+						"duration": schema.StringAttribute{
+							MarkdownDescription: "Amount of time the sync window is open.",
+							Computed:            true,
+						},
+						"timezone": schema.StringAttribute{
+							MarkdownDescription: "Timezone that the schedule is evaluated in.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func projectClusterResourceRestrictionSchemaAttribute(description string) schema.Attribute {
+	return schema.ListNestedAttribute{
+		MarkdownDescription: description,
+		Computed:            true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"group": schema.StringAttribute{
+					MarkdownDescription: "The Kubernetes resource Group.",
+					Computed:            true,
+				},
+				"kind": schema.StringAttribute{
+					MarkdownDescription: "The Kubernetes resource Kind.",
+					Computed:            true,
+				},
+				"name": schema.StringAttribute{
+					MarkdownDescription: "The Kubernetes resource name.",
+					Computed:            true,
+				},
+			},
+		},
+	}
+}
+
+func projectGroupKindSchemaAttribute(description string) schema.Attribute {
+	return schema.ListNestedAttribute{
+		MarkdownDescription: description,
+		Computed:            true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"group": schema.StringAttribute{
+					MarkdownDescription: "The Kubernetes resource Group.",
+					Computed:            true,
+				},
+				"kind": schema.StringAttribute{
+					MarkdownDescription: "The Kubernetes resource Kind.",
+					Computed:            true,
+				},
+			},
+		},
+	}
+}
+
+func newProjectsItem(p *v1alpha1.AppProject) projectsItemModel {
+	pm := newProject(p)
+
+	return projectsItemModel{
+		Name:     types.StringValue(p.Name),
+		Metadata: &pm.Metadata[0],
+		Spec:     &pm.Spec[0],
+	}
+}
+
+func newProjectDataSource(dp *project.DetailedProjectsResponse) projectDataSourceModel {
+	item := newProjectsItem(dp.Project)
+
+	m := projectDataSourceModel{
+		ID:             types.StringValue(dp.Project.Name),
+		Name:           item.Name,
+		Metadata:       item.Metadata,
+		Spec:           item.Spec,
+		GlobalProjects: make([]types.String, len(dp.GlobalProjects)),
+		Repositories:   make([]projectRepositoryModel, len(dp.Repositories)),
+		Clusters:       make([]projectClusterModel, len(dp.Clusters)),
+	}
+
+	for i, gp := range dp.GlobalProjects {
+		m.GlobalProjects[i] = types.StringValue(gp.Name)
+	}
+
+	for i, r := range dp.Repositories {
+		m.Repositories[i] = projectRepositoryModel{
+			Repo: types.StringValue(r.Repo),
+			Name: types.StringValue(r.Name),
+			Type: types.StringValue(r.Type),
+		}
+	}
+
+	for i, c := range dp.Clusters {
+		m.Clusters[i] = projectClusterModel{
+			Server: types.StringValue(c.Server),
+			Name:   types.StringValue(c.Name),
+		}
+	}
+
+	return m
+}
@@ -0,0 +1,102 @@
+package provider
+
+import (
+	"github.com/argoproj-labs/terraform-provider-argocd/internal/validators"
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type projectRoleResourceModel struct {
+	ID          types.String   `tfsdk:"id"`
+	Project     types.String   `tfsdk:"project"`
+	Name        types.String   `tfsdk:"name"`
+	Description types.String   `tfsdk:"description"`
+	Policies    []types.String `tfsdk:"policies"`
+	Groups      []types.String `tfsdk:"groups"`
+}
+
+func projectRoleSchemaAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Description: "Project role identifier",
+			Computed:    true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"project": schema.StringAttribute{
+			Description: "The project the role belongs to.",
+			Required:    true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"name": schema.StringAttribute{
+			Description: "The name of the role.",
+			Required:    true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+			Validators: []validator.String{
+				validators.RoleNameValidator(),
+			},
+		},
+		"description": schema.StringAttribute{
+			Description: "Description of the role.",
+			Optional:    true,
+		},
+		"policies": schema.ListAttribute{
+			MarkdownDescription: "List of casbin formatted strings that define access policies for the role in the project. For more information, see the [ArgoCD RBAC reference](https://argoproj.github.io/argo-cd/operator-manual/rbac/#rbac-permission-structure).",
+			Required:            true,
+			ElementType:         types.StringType,
+		},
+		"groups": schema.ListAttribute{
+			Description: "List of OIDC group claims bound to this role.",
+			Optional:    true,
+			ElementType: types.StringType,
+		},
+	}
+}
+
+// newProjectRole converts a role read from ArgoCD. Empty lists in the prior
+// model are retained as ArgoCD does not distinguish them from unset lists.
+func newProjectRole(projectName string, role *v1alpha1.ProjectRole, prior *projectRoleResourceModel) *projectRoleResourceModel {
+	r := &projectRoleResourceModel{
+		ID:          types.StringValue(projectName + ":" + role.Name),
+		Project:     types.StringValue(projectName),
+		Name:        types.StringValue(role.Name),
+		Description: types.StringNull(),
+		Policies:    stringsOrPrior(role.Policies, prior.Policies),
+		Groups:      stringsOrPrior(role.Groups, prior.Groups),
+	}
+
+	if role.Description != "" {
+		r.Description = types.StringValue(role.Description)
+	}
+
+	return r
+}
+
+// applyTo sets the role attributes managed by the resource on the given role,
+// leaving other fields, such as JWT tokens, untouched.
+func (m *projectRoleResourceModel) applyTo(role *v1alpha1.ProjectRole) {
+	role.Name = m.Name.ValueString()
+	role.Description = m.Description.ValueString()
+
+	role.Policies = make([]string, len(m.Policies))
+	for i, p := range m.Policies {
+		role.Policies[i] = p.ValueString()
+	}
+
+	role.Groups = nil
+	if m.Groups != nil {
+		role.Groups = make([]string, len(m.Groups))
+		for i, g := range m.Groups {
+			role.Groups[i] = g.ValueString()
+		}
+	}
+}
@@ -0,0 +1,219 @@
+package provider
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/argoproj-labs/terraform-provider-argocd/internal/validators"
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type projectSyncWindowModel struct {
+	ID             types.String   `tfsdk:"id"`
+	Project        types.String   `tfsdk:"project"`
+	Kind           types.String   `tfsdk:"kind"`
+	Schedule       types.String   `tfsdk:"schedule"`
+	Duration       types.String   `tfsdk:"duration"`
+	Timezone       types.String   `tfsdk:"timezone"`
+	Applications   []types.String `tfsdk:"applications"`
+	Clusters       []types.String `tfsdk:"clusters"`
+	Namespaces     []types.String `tfsdk:"namespaces"`
+	ManualSync     types.Bool     `tfsdk:"manual_sync"`
+	UseAndOperator types.Bool     `tfsdk:"use_and_operator"`
+}
+
+func projectSyncWindowSchemaAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Description: "Sync window identifier",
+			Computed:    true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"project": schema.StringAttribute{
+			Description: "The project the sync window belongs to.",
+			Required:    true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"kind": schema.StringAttribute{
+			MarkdownDescription: "Defines if the window allows or blocks syncs, allowed values are `allow` or `deny`.",
+			Required:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+			Validators: []validator.String{
+				validators.SyncWindowKindValidator(),
+			},
+		},
+		"schedule": schema.StringAttribute{
+			Description: "Time the window will begin, specified in cron format.",
+			Required:    true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+			Validators: []validator.String{
+				validators.SyncWindowScheduleValidator(),
+			},
+		},
+		"duration": schema.StringAttribute{
+			Description: "Amount of time the sync window will be open.",
+			Required:    true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+			Validators: []validator.String{
+				validators.SyncWindowDurationValidator(),
+			},
+		},
+		"timezone": schema.StringAttribute{
+			Description: "Timezone that the schedule will be evaluated in.",
+			Optional:    true,
+			Computed:    true,
+			Default:     stringdefault.StaticString("UTC"),
+			Validators: []validator.String{
+				validators.SyncWindowTimezoneValidator(),
+			},
+		},
+		"applications": schema.ListAttribute{
+			Description: "List of applications that the window will apply to.",
+			Optional:    true,
+			ElementType: types.StringType,
+		},
+		"clusters": schema.ListAttribute{
+			Description: "List of clusters that the window will apply to.",
+			Optional:    true,
+			ElementType: types.StringType,
+		},
+		"namespaces": schema.ListAttribute{
+			Description: "List of namespaces that the window will apply to.",
+			Optional:    true,
+			ElementType: types.StringType,
+		},
+		"manual_sync": schema.BoolAttribute{
+			Description: "Enables manual syncs when they would otherwise be blocked.",
+			Optional:    true,
+			Computed:    true,
+			Default:     booldefault.StaticBool(false),
+		},
+		"use_and_operator": schema.BoolAttribute{
+			Description: "Defines if the AND operator should be used among the various conditions for the sync window.",
+			Optional:    true,
+			Computed:    true,
+			Default:     booldefault.StaticBool(false),
+		},
+	}
+}
+
+// syncWindowID returns the identifier of a sync window, made of its kind,
+// schedule and duration, none of which may contain a colon. ArgoCD does not
+// name sync windows, and several windows with the same identifier may exist
+// when they target different applications, clusters or namespaces.
+func syncWindowID(projectName, kind, schedule, duration string) string {
+	return fmt.Sprintf("%s:%s:%s:%s", projectName, kind, schedule, duration)
+}
+
+// matches reports whether the given sync window is the one managed by the
+// model, i.e. it has the same kind, schedule, duration and targets.
+func (m *projectSyncWindowModel) matches(w *v1alpha1.SyncWindow) bool {
+	return m.matchesSchedule(w) &&
+		equalStrings(w.Applications, m.Applications) &&
+		equalStrings(w.Clusters, m.Clusters) &&
+		equalStrings(w.Namespaces, m.Namespaces)
+}
+
+// matchesSchedule reports whether the given sync window has the kind, schedule
+// and duration of the model, regardless of its targets.
+func (m *projectSyncWindowModel) matchesSchedule(w *v1alpha1.SyncWindow) bool {
+	return w.Kind == m.Kind.ValueString() &&
+		w.Schedule == m.Schedule.ValueString() &&
+		w.Duration == m.Duration.ValueString()
+}
+
+// equalStrings reports whether the values are equal to the model values, with
+// unset lists being equal to empty lists.
+func equalStrings(values []string, models []types.String) bool {
+	return slices.EqualFunc(values, models, func(v string, m types.String) bool { return m.ValueString() == v })
+}
+
+// equalStringModels reports whether both lists hold the same values, with unset
+// lists being equal to empty lists.
+func equalStringModels(a, b []types.String) bool {
+	return slices.EqualFunc(a, b, func(x, y types.String) bool { return x.ValueString() == y.ValueString() })
+}
+
+func (m *projectSyncWindowModel) toSyncWindow() *v1alpha1.SyncWindow {
+	w := &v1alpha1.SyncWindow{
+		Kind:           m.Kind.ValueString(),
+		Schedule:       m.Schedule.ValueString(),
+		Duration:       m.Duration.ValueString(),
+		TimeZone:       m.Timezone.ValueString(),
+		ManualSync:     m.ManualSync.ValueBool(),
+		UseAndOperator: m.UseAndOperator.ValueBool(),
+	}
+
+	for _, a := range m.Applications {
+		w.Applications = append(w.Applications, a.ValueString())
+	}
+
+	for _, c := range m.Clusters {
+		w.Clusters = append(w.Clusters, c.ValueString())
+	}
+
+	for _, n := range m.Namespaces {
+		w.Namespaces = append(w.Namespaces, n.ValueString())
+	}
+
+	return w
+}
+
+// newProjectSyncWindow converts a sync window read from ArgoCD. Empty lists in
+// the prior model are retained as ArgoCD does not distinguish them from unset
+// lists.
+func newProjectSyncWindow(projectName string, w *v1alpha1.SyncWindow, prior *projectSyncWindowModel) *projectSyncWindowModel {
+	m := &projectSyncWindowModel{
+		ID:             types.StringValue(syncWindowID(projectName, w.Kind, w.Schedule, w.Duration)),
+		Project:        types.StringValue(projectName),
+		Kind:           types.StringValue(w.Kind),
+		Schedule:       types.StringValue(w.Schedule),
+		Duration:       types.StringValue(w.Duration),
+		Timezone:       types.StringValue("UTC"),
+		Applications:   stringsOrPrior(w.Applications, prior.Applications),
+		Clusters:       stringsOrPrior(w.Clusters, prior.Clusters),
+		Namespaces:     stringsOrPrior(w.Namespaces, prior.Namespaces),
+		ManualSync:     types.BoolValue(w.ManualSync),
+		UseAndOperator: types.BoolValue(w.UseAndOperator),
+	}
+
+	if w.TimeZone != "" {
+		m.Timezone = types.StringValue(w.TimeZone)
+	}
+
+	return m
+}
+
+func stringsOrPrior(values []string, prior []types.String) []types.String {
+	if len(values) == 0 {
+		if prior != nil && len(prior) == 0 {
+			return prior
+		}
+
+		return nil
+	}
+
+	result := make([]types.String, len(values))
+	for i, v := range values {
+		result[i] = types.StringValue(v)
+	}
+
+	return result
+}
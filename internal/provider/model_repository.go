@@ -41,6 +41,7 @@ type repositoryModel struct {
 	Proxy                      types.String `tfsdk:"proxy"`
 	NoProxy                    types.String `tfsdk:"no_proxy"`
 	Depth                      types.Int64  `tfsdk:"depth"`
+	AdoptExisting              types.Bool   `tfsdk:"adopt_existing"`
 }
 
 func repositorySchemaAttributes() map[string]schema.Attribute {
@@ -173,6 +174,10 @@ func repositorySchemaAttributes() map[string]schema.Attribute {
 				int64validator.AtLeast(0),
 			},
 		},
+		"adopt_existing": schema.BoolAttribute{
+			MarkdownDescription: "Whether to take over a pre-existing repository with the same URL and project on creation instead of failing when its configuration differs. The existing repository is overwritten with the configuration.",
+			Optional:            true,
+		},
 	}
 }
 
@@ -0,0 +1,142 @@
+package provider
+
+import (
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type repositoryDataSourceModel struct {
+	ID              types.String                    `tfsdk:"id"`
+	Repo            types.String                    `tfsdk:"repo"`
+	Project         types.String                    `tfsdk:"project"`
+	Name            types.String                    `tfsdk:"name"`
+	Type            types.String                    `tfsdk:"type"`
+	Username        types.String                    `tfsdk:"username"`
+	EnableLFS       types.Bool                      `tfsdk:"enable_lfs"`
+	EnableOCI       types.Bool                      `tfsdk:"enable_oci"`
+	Insecure        types.Bool                      `tfsdk:"insecure"`
+	InheritedCreds  types.Bool                      `tfsdk:"inherited_creds"`
+	Proxy           types.String                    `tfsdk:"proxy"`
+	NoProxy         types.String                    `tfsdk:"no_proxy"`
+	Depth           types.Int64                     `tfsdk:"depth"`
+	ConnectionState *repositoryConnectionStateModel `tfsdk:"connection_state"`
+}
+
+type repositoryConnectionStateModel struct {
+	Status  types.String `tfsdk:"status"`
+	Message types.String `tfsdk:"message"`
+}
+
+func repositoryDataSourceSchemaAttributes(lookup bool) map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			MarkdownDescription: "Repository identifier, in the same format as the `argocd_repository` resource identifier.",
+			Computed:            true,
+		},
+		"repo": schema.StringAttribute{
+			MarkdownDescription: "URL of the repository.",
+			Required:            lookup,
+			Computed:            !lookup,
+		},
+		"project": schema.StringAttribute{
+			MarkdownDescription: "Project to which the repository is scoped, if any.",
+			Optional:            lookup,
+			Computed:            true,
+		},
+		"name": schema.StringAttribute{
+			MarkdownDescription: "Name of the repository. Only used with Helm repos.",
+			Computed:            true,
+		},
+		"type": schema.StringAttribute{
+			MarkdownDescription: "Type of the repository, either `git`, `helm` or `oci`.",
+			Computed:            true,
+		},
+		"username": schema.StringAttribute{
+			MarkdownDescription: "Username used for authenticating at the remote repository.",
+			Computed:            true,
+		},
+		"enable_lfs": schema.BoolAttribute{
+			MarkdownDescription: "Whether `git-lfs` support is enabled for the repository.",
+			Computed:            true,
+		},
+		"enable_oci": schema.BoolAttribute{
+			MarkdownDescription: "Whether `helm-oci` support is enabled for the repository.",
+			Computed:            true,
+		},
+		"insecure": schema.BoolAttribute{
+			MarkdownDescription: "Whether the connection to the repository ignores any errors when verifying TLS certificates or SSH host keys.",
+			Computed:            true,
+		},
+		"inherited_creds": schema.BoolAttribute{
+			MarkdownDescription: "Whether credentials were inherited from a credential set.",
+			Computed:            true,
+		},
+		"proxy": schema.StringAttribute{
+			MarkdownDescription: "HTTP/HTTPS proxy used to access the repository.",
+			Computed:            true,
+		},
+		"no_proxy": schema.StringAttribute{
+			MarkdownDescription: "Comma-separated list of hostnames that are excluded from proxying.",
+			Computed:            true,
+		},
+		"depth": schema.Int64Attribute{
+			MarkdownDescription: "Depth used for shallow clones of the repository. `0` means a full clone.",
+			Computed:            true,
+		},
+		"connection_state": schema.SingleNestedAttribute{
+			MarkdownDescription: "Information about the connection to the repository.",
+			Computed:            true,
+			Attributes: map[string]schema.Attribute{
+				"status": schema.StringAttribute{
+					MarkdownDescription: "Current status indicator for the connection.",
+					Computed:            true,
+				},
+				"message": schema.StringAttribute{
+					MarkdownDescription: "Human readable information about the connection status.",
+					Computed:            true,
+				},
+			},
+		},
+	}
+}
+
+func newRepositoryDataSource(r *v1alpha1.Repository) repositoryDataSourceModel {
+	m := repositoryDataSourceModel{
+		ID:             types.StringValue(r.Repo),
+		Repo:           types.StringValue(r.Repo),
+		Project:        types.StringValue(r.Project),
+		Name:           types.StringValue(r.Name),
+		Type:           types.StringValue(repositoryType(r)),
+		Username:       types.StringValue(r.Username),
+		EnableLFS:      types.BoolValue(r.EnableLFS),
+		EnableOCI:      types.BoolValue(r.EnableOCI),
+		Insecure:       types.BoolValue(r.Insecure),
+		InheritedCreds: types.BoolValue(r.InheritedCreds),
+		Proxy:          types.StringValue(r.Proxy),
+		NoProxy:        types.StringValue(r.NoProxy),
+		Depth:          types.Int64Value(r.Depth),
+		ConnectionState: &repositoryConnectionStateModel{
+			Status:  types.StringValue(r.ConnectionState.Status),
+			Message: types.StringValue(r.ConnectionState.Message),
+		},
+	}
+
+	// Use the same "|" separator as the argocd_repository resource for project
+	// scoped repositories
+	if r.Project != "" {
+		m.ID = types.StringValue(r.Repo + "|" + r.Project)
+	}
+
+	return m
+}
+
+// repositoryType returns the type of the repository, defaulting to `git` as
+// ArgoCD does when the type is not set.
+func repositoryType(r *v1alpha1.Repository) string {
+	if r.Type == "" {
+		return "git"
+	}
+
+	return r.Type
+}
@@ -0,0 +1,215 @@
+package provider
+
+import (
+	"github.com/argoproj/argo-cd/v3/pkg/apiclient/settings"
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/elliotchance/pie/v2"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type settingsModel struct {
+	ID                    types.String                     `tfsdk:"id"`
+	URL                   types.String                     `tfsdk:"url"`
+	AppLabelKey           types.String                     `tfsdk:"app_label_key"`
+	TrackingMethod        types.String                     `tfsdk:"tracking_method"`
+	ControllerNamespace   types.String                     `tfsdk:"controller_namespace"`
+	DexConfigured         types.Bool                       `tfsdk:"dex_configured"`
+	OIDCConfigured        types.Bool                       `tfsdk:"oidc_configured"`
+	OIDCIssuer            types.String                     `tfsdk:"oidc_issuer"`
+	UserLoginsDisabled    types.Bool                       `tfsdk:"user_logins_disabled"`
+	ExecEnabled           types.Bool                       `tfsdk:"exec_enabled"`
+	StatusBadgeEnabled    types.Bool                       `tfsdk:"status_badge_enabled"`
+	StatusBadgeRootURL    types.String                     `tfsdk:"status_badge_root_url"`
+	PasswordPattern       types.String                     `tfsdk:"password_pattern"`
+	KustomizeBuildOptions types.String                     `tfsdk:"kustomize_build_options"`
+	KustomizeVersions     []types.String                   `tfsdk:"kustomize_versions"`
+	Plugins               []types.String                   `tfsdk:"plugins"`
+	ResourceOverrides     map[string]resourceOverrideModel `tfsdk:"resource_overrides"`
+}
+
+type resourceOverrideModel struct {
+	HealthLua         types.String            `tfsdk:"health_lua"`
+	UseOpenLibs       types.Bool              `tfsdk:"use_open_libs"`
+	Actions           types.String            `tfsdk:"actions"`
+	IgnoreDifferences overrideIgnoreDiffModel `tfsdk:"ignore_differences"`
+}
+
+type overrideIgnoreDiffModel struct {
+	JSONPointers          []types.String `tfsdk:"json_pointers"`
+	JQPathExpressions     []types.String `tfsdk:"jq_path_expressions"`
+	ManagedFieldsManagers []types.String `tfsdk:"managed_fields_managers"`
+}
+
+func settingsSchemaAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			MarkdownDescription: "Settings identifier",
+			Computed:            true,
+		},
+		"url": schema.StringAttribute{
+			MarkdownDescription: "External URL of the ArgoCD server.",
+			Computed:            true,
+		},
+		"app_label_key": schema.StringAttribute{
+			MarkdownDescription: "Label key used to track the application a resource belongs to.",
+			Computed:            true,
+		},
+		"tracking_method": schema.StringAttribute{
+			MarkdownDescription: "Method used to track application resources, e.g. `label`, `annotation` or `annotation+label`.",
+			Computed:            true,
+		},
+		"controller_namespace": schema.StringAttribute{
+			MarkdownDescription: "Namespace in which the application controller runs.",
+			Computed:            true,
+		},
+		"dex_configured": schema.BoolAttribute{
+			MarkdownDescription: "Whether Dex is configured with at least one connector.",
+			Computed:            true,
+		},
+		"oidc_configured": schema.BoolAttribute{
+			MarkdownDescription: "Whether an external OIDC provider is configured.",
+			Computed:            true,
+		},
+		"oidc_issuer": schema.StringAttribute{
+			MarkdownDescription: "Issuer of the external OIDC provider, if configured.",
+			Computed:            true,
+		},
+		"user_logins_disabled": schema.BoolAttribute{
+			MarkdownDescription: "Whether logins with local users are disabled.",
+			Computed:            true,
+		},
+		"exec_enabled": schema.BoolAttribute{
+			MarkdownDescription: "Whether the web-based terminal is enabled.",
+			Computed:            true,
+		},
+		"status_badge_enabled": schema.BoolAttribute{
+			MarkdownDescription: "Whether application status badges are enabled.",
+			Computed:            true,
+		},
+		"status_badge_root_url": schema.StringAttribute{
+			MarkdownDescription: "Root URL used for status badges, if different from `url`.",
+			Computed:            true,
+		},
+		"password_pattern": schema.StringAttribute{
+			MarkdownDescription: "Regular expression local account passwords must match.",
+			Computed:            true,
+		},
+		"kustomize_build_options": schema.StringAttribute{
+			MarkdownDescription: "Default options passed to `kustomize build`.",
+			Computed:            true,
+		},
+		"kustomize_versions": schema.ListAttribute{
+			MarkdownDescription: "Additional Kustomize versions available to applications.",
+			Computed:            true,
+			ElementType:         types.StringType,
+		},
+		"plugins": schema.ListAttribute{
+			MarkdownDescription: "Names of the configured config management plugins.",
+			Computed:            true,
+			ElementType:         types.StringType,
+		},
+		"resource_overrides": schema.MapNestedAttribute{
+			MarkdownDescription: "Resource customizations, keyed by `group/kind`.",
+			Computed:            true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"health_lua": schema.StringAttribute{
+						MarkdownDescription: "Lua script defining custom health checks.",
+						Computed:            true,
+					},
+					"use_open_libs": schema.BoolAttribute{
+						MarkdownDescription: "Whether the health check script can use the Lua standard libraries.",
+						Computed:            true,
+					},
+					"actions": schema.StringAttribute{
+						MarkdownDescription: "Lua scripts defining custom resource actions.",
+						Computed:            true,
+					},
+					"ignore_differences": schema.SingleNestedAttribute{
+						MarkdownDescription: "Differences ignored when comparing live and desired state.",
+						Computed:            true,
+						Attributes: map[string]schema.Attribute{
+							"json_pointers": schema.ListAttribute{
+								MarkdownDescription: "JSON pointers of ignored fields.",
+								Computed:            true,
+								ElementType:         types.StringType,
+							},
+							"jq_path_expressions": schema.ListAttribute{
+								MarkdownDescription: "JQ path expressions of ignored fields.",
+								Computed:            true,
+								ElementType:         types.StringType,
+							},
+							"managed_fields_managers": schema.ListAttribute{
+								MarkdownDescription: "Managers whose changes are ignored.",
+								Computed:            true,
+								ElementType:         types.StringType,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func newSettings(s *settings.Settings) settingsModel {
+	m := settingsModel{
+		ID:                  types.StringValue("settings"),
+		URL:                 types.StringValue(s.URL),
+		AppLabelKey:         types.StringValue(s.AppLabelKey),
+		TrackingMethod:      types.StringValue(s.TrackingMethod),
+		ControllerNamespace: types.StringValue(s.ControllerNamespace),
+		DexConfigured:       types.BoolValue(s.DexConfig != nil && len(s.DexConfig.Connectors) > 0),
+		OIDCConfigured:      types.BoolValue(s.OIDCConfig != nil),
+		OIDCIssuer:          types.StringNull(),
+		UserLoginsDisabled:  types.BoolValue(s.UserLoginsDisabled),
+		ExecEnabled:         types.BoolValue(s.ExecEnabled),
+		StatusBadgeEnabled:  types.BoolValue(s.StatusBadgeEnabled),
+		StatusBadgeRootURL:  types.StringValue(s.StatusBadgeRootUrl),
+		PasswordPattern:     types.StringValue(s.PasswordPattern),
+		KustomizeVersions:   pie.Map(s.KustomizeVersions, types.StringValue),
+		Plugins:             make([]types.String, 0, len(s.Plugins)+len(s.ConfigManagementPlugins)),
+		ResourceOverrides:   make(map[string]resourceOverrideModel, len(s.ResourceOverrides)),
+	}
+
+	if s.OIDCConfig != nil {
+		m.OIDCIssuer = types.StringValue(s.OIDCConfig.Issuer)
+	}
+
+	m.KustomizeBuildOptions = types.StringNull()
+	if s.KustomizeOptions != nil {
+		m.KustomizeBuildOptions = types.StringValue(s.KustomizeOptions.BuildOptions)
+	}
+
+	for _, p := range s.Plugins {
+		m.Plugins = append(m.Plugins, types.StringValue(p.Name))
+	}
+
+	for _, p := range s.ConfigManagementPlugins {
+		m.Plugins = append(m.Plugins, types.StringValue(p.Name))
+	}
+
+	for k, v := range s.ResourceOverrides {
+		if v == nil {
+			continue
+		}
+
+		m.ResourceOverrides[k] = newResourceOverride(v)
+	}
+
+	return m
+}
+
+func newResourceOverride(ro *v1alpha1.ResourceOverride) resourceOverrideModel {
+	return resourceOverrideModel{
+		HealthLua:   types.StringValue(ro.HealthLua),
+		UseOpenLibs: types.BoolValue(ro.UseOpenLibs),
+		Actions:     types.StringValue(ro.Actions),
+		IgnoreDifferences: overrideIgnoreDiffModel{
+			JSONPointers:          pie.Map(ro.IgnoreDifferences.JSONPointers, types.StringValue),
+			JQPathExpressions:     pie.Map(ro.IgnoreDifferences.JQPathExpressions, types.StringValue),
+			ManagedFieldsManagers: pie.Map(ro.IgnoreDifferences.ManagedFieldsManagers, types.StringValue),
+		},
+	}
+}
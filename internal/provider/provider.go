@@ -3,6 +3,7 @@ package provider
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/providervalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
@@ -123,6 +124,13 @@ func (p *ArgoCDProvider) Schema(ctx context.Context, req provider.SchemaRequest,
 				Description: "User-Agent request header override.",
 				Optional:    true,
 			},
+			"shard_count": schema.Int64Attribute{
+				Description: "Number of application controller shards. When set, clusters managed by `argocd_cluster` without an explicit `shard` are assigned to a shard deterministically computed from their server address.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
 		},
 		Blocks: map[string]schema.Block{
 			"kubernetes": schema.ListNestedBlock{
@@ -254,17 +262,36 @@ func (p *ArgoCDProvider) Configure(ctx context.Context, req provider.ConfigureRe
 
 func (p *ArgoCDProvider) Resources(context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
+		NewAccountPasswordResource,
 		NewGPGKeyResource,
 		NewRepositoryResource,
 		NewRepositoryCertificateResource,
 		NewRepositoryCredentialsResource,
 		NewProjectResource,
+		NewProjectRoleResource,
+		NewProjectSyncWindowResource,
 		NewProjectTokenResource,
 	}
 }
 
 func (p *ArgoCDProvider) DataSources(context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
+		NewArgoCDAccountDataSource,
+		NewArgoCDAccountsDataSource,
 		NewArgoCDApplicationDataSource,
+		NewArgoCDCanIDataSource,
+		NewArgoCDClusterDataSource,
+		NewArgoCDClustersDataSource,
+		NewArgoCDProjectDataSource,
+		NewArgoCDProjectsDataSource,
+		NewArgoCDRepositoriesDataSource,
+		NewArgoCDRepositoryDataSource,
+		NewArgoCDRepositoryAccessDataSource,
+		NewArgoCDRepositoryHelmChartsDataSource,
+		NewArgoCDRepositoryRefsDataSource,
+		NewArgoCDSettingsDataSource,
+		NewArgoCDSyncWindowStateDataSource,
+		NewArgoCDUserInfoDataSource,
+		NewArgoCDVersionDataSource,
 	}
 }
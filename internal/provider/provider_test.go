@@ -3,13 +3,16 @@ package provider
 import (
 	"context"
 	"os"
+	"strconv"
 	"testing"
+	"time"
 
 	"github.com/Masterminds/semver/v3"
 	"github.com/argoproj-labs/terraform-provider-argocd/argocd"
 	"github.com/argoproj-labs/terraform-provider-argocd/internal/features"
 	"github.com/argoproj-labs/terraform-provider-argocd/internal/testhelpers"
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 	"github.com/hashicorp/terraform-plugin-mux/tf5to6server"
 	"github.com/hashicorp/terraform-plugin-mux/tf6muxserver"
@@ -89,6 +92,31 @@ func testAccPreCheck(t *testing.T) {
 	}
 }
 
+// testAccServerInterface returns initialized API clients for the acceptance
+// test server, to create or mutate objects outside of Terraform.
+func testAccServerInterface(t *testing.T) *ServerInterface {
+	ctx, cancel := context.WithTimeout(t.Context(), 30*time.Second)
+	defer cancel()
+
+	insecure, err := strconv.ParseBool(os.Getenv("ARGOCD_INSECURE"))
+	if err != nil {
+		t.Fatalf("failed to parse 'ARGOCD_INSECURE' env var to bool: %s", err.Error())
+	}
+
+	si := NewServerInterface(ArgoCDProviderConfig{
+		ServerAddr: types.StringValue(os.Getenv("ARGOCD_SERVER")),
+		Insecure:   types.BoolValue(insecure),
+		Username:   types.StringValue(os.Getenv("ARGOCD_AUTH_USERNAME")),
+		Password:   types.StringValue(os.Getenv("ARGOCD_AUTH_PASSWORD")),
+	})
+
+	if diags := si.InitClients(ctx); diags.HasError() {
+		t.Fatalf("failed to init clients: %v", diags.Errors())
+	}
+
+	return si
+}
+
 // Skip test if feature is not supported
 func testAccPreCheckFeatureSupported(t *testing.T, feature features.Feature) {
 	v := os.Getenv("ARGOCD_VERSION")
@@ -0,0 +1,238 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/argoproj-labs/terraform-provider-argocd/internal/diagnostics"
+	"github.com/argoproj/argo-cd/v3/pkg/apiclient/account"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &accountPasswordResource{}
+var _ resource.ResourceWithModifyPlan = &accountPasswordResource{}
+
+func NewAccountPasswordResource() resource.Resource {
+	return &accountPasswordResource{}
+}
+
+type accountPasswordResource struct {
+	si *ServerInterface
+}
+
+func (r *accountPasswordResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_account_password"
+}
+
+func (r *accountPasswordResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages the password of an ArgoCD [local account](https://argo-cd.readthedocs.io/en/stable/operator-manual/user-management/#local-usersaccounts). Requires Terraform 1.11 or later for write-only attributes.\n\n~> **Note** ArgoCD does not expose account passwords, so changes made outside of Terraform cannot be detected. Destroying this resource leaves the password unchanged. When changing the password of the account the provider is authenticated as, update the provider `password` accordingly before the next run.\n",
+		Attributes:          accountPasswordSchemaAttributes(),
+	}
+}
+
+func (r *accountPasswordResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	si, ok := req.ProviderData.(*ServerInterface)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Data Type",
+			fmt.Sprintf("Expected *ServerInterface, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.si = si
+}
+
+func (r *accountPasswordResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || req.State.Raw.IsNull() {
+		// Resource is being created or destroyed
+		return
+	}
+
+	var stateData *accountPasswordModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &stateData)...)
+
+	var password types.String
+
+	// Write-only attributes are only available in the configuration
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("password_wo"), &password)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if password.IsUnknown() || bcrypt.CompareHashAndPassword([]byte(stateData.PasswordHash.ValueString()), []byte(password.ValueString())) != nil {
+		// Password differs from the one last set - force update
+		resp.Plan.SetAttribute(ctx, path.Root("password_hash"), types.StringUnknown())
+	}
+}
+
+func (r *accountPasswordResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *accountPasswordModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	// Initialize API clients
+	resp.Diagnostics.Append(r.si.InitClients(ctx)...)
+
+	// Check for errors before proceeding
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.updatePassword(ctx, req.Config, data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("set password of account %s", data.Account.ValueString()))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *accountPasswordResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *accountPasswordModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	// Initialize API clients
+	resp.Diagnostics.Append(r.si.InitClients(ctx)...)
+
+	// Check for errors before proceeding
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name := data.Account.ValueString()
+
+	// Delete password from state if account has been removed in an out-of-band fashion
+	_, err := r.si.AccountClient.GetAccount(ctx, &account.GetAccountRequest{Name: name})
+	if err != nil {
+		if strings.Contains(err.Error(), "NotFound") {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.Append(diagnostics.ArgoCDAPIError("read", "account", name, err)...)
+
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *accountPasswordResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *accountPasswordModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	// Initialize API clients
+	resp.Diagnostics.Append(r.si.InitClients(ctx)...)
+
+	// Check for errors before proceeding
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.updatePassword(ctx, req.Config, data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("updated password of account %s", data.Account.ValueString()))
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *accountPasswordResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *accountPasswordModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	// Check for errors before proceeding
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Passwords cannot be unset, so the resource is only removed from state
+	tflog.Trace(ctx, fmt.Sprintf("removed password of account %s from state", data.Account.ValueString()))
+}
+
+// updatePassword sets the password of the account using the write-only
+// attributes from the configuration and records its hash in data.
+func (r *accountPasswordResource) updatePassword(ctx context.Context, config tfsdk.Config, data *accountPasswordModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var password, currentPassword types.String
+
+	diags.Append(config.GetAttribute(ctx, path.Root("password_wo"), &password)...)
+	diags.Append(config.GetAttribute(ctx, path.Root("current_password_wo"), &currentPassword)...)
+
+	if diags.HasError() {
+		return diags
+	}
+
+	name := data.Account.ValueString()
+
+	if currentPassword.IsNull() {
+		currentPassword = types.StringValue(getDefaultString(r.si.config.Password, "ARGOCD_AUTH_PASSWORD"))
+	}
+
+	if currentPassword.ValueString() == "" {
+		diags.AddError(
+			"Missing Current Password",
+			fmt.Sprintf("current_password_wo must be set to update the password of account %s when the provider is not configured with a password", name),
+		)
+
+		return diags
+	}
+
+	// The password is hashed beforehand as bcrypt rejects passwords ArgoCD
+	// accepts, which would otherwise be changed without being saved to state
+	hash, err := bcrypt.GenerateFromPassword([]byte(password.ValueString()), bcrypt.DefaultCost)
+	if err != nil {
+		diags.Append(diagnostics.Error(fmt.Sprintf("failed to hash password of account %s", name), err)...)
+		return diags
+	}
+
+	_, err = r.si.AccountClient.UpdatePassword(ctx, &account.UpdatePasswordRequest{
+		Name:            name,
+		NewPassword:     password.ValueString(),
+		CurrentPassword: currentPassword.ValueString(),
+	})
+	if err != nil {
+		diags.Append(diagnostics.ArgoCDAPIError("update", "password of account", name, err)...)
+		return diags
+	}
+
+	data.ID = types.StringValue(name)
+	data.PasswordHash = types.StringValue(string(hash))
+
+	return diags
+}
@@ -0,0 +1,82 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccArgoCDAccountPassword(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccArgoCDAccountPassword("password1234", "1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("argocd_account_password.test", "id", "test"),
+					resource.TestCheckResourceAttrSet("argocd_account_password.test", "password_hash"),
+					resource.TestCheckNoResourceAttr("argocd_account_password.test", "password_wo"),
+				),
+			},
+			{
+				// Unchanged password should not trigger an update
+				Config: testAccArgoCDAccountPassword("password1234", "1"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+			{
+				Config: testAccArgoCDAccountPassword("password5678", "1"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("argocd_account_password.test", plancheck.ResourceActionUpdate),
+						plancheck.ExpectUnknownValue("argocd_account_password.test", tfjsonpath.New("password_hash")),
+					},
+				},
+			},
+			{
+				Config: testAccArgoCDAccountPassword("password5678", "2"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("argocd_account_password.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.TestCheckResourceAttr("argocd_account_password.test", "rotation_triggers.rotation", "2"),
+			},
+			{
+				// Passwords bcrypt cannot hash are rejected before being changed in ArgoCD
+				Config:      testAccArgoCDAccountPassword(strings.Repeat("x", 73), "2"),
+				ExpectError: regexp.MustCompile("failed to hash password of account test"),
+			},
+			{
+				Config: testAccArgoCDAccountPassword("password5678", "2"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+		},
+	})
+}
+
+func testAccArgoCDAccountPassword(password, rotation string) string {
+	return fmt.Sprintf(`
+resource "argocd_account_password" "test" {
+  account     = "test"
+  password_wo = "%s"
+
+  rotation_triggers = {
+    rotation = "%s"
+  }
+}
+`, password, rotation)
+}
@@ -3,6 +3,7 @@ package provider
 import (
 	"context"
 	"fmt"
+	"slices"
 	"strings"
 	"time"
 
@@ -43,6 +44,18 @@ func (r *projectResource) Schema(ctx context.Context, req resource.SchemaRequest
 				Description: "Project identifier",
 				Computed:    true,
 			},
+			"adopt_existing": schema.BoolAttribute{
+				MarkdownDescription: "Whether to take over a pre-existing project with the same name on creation instead of failing when its spec differs. The existing project is overwritten with the configuration.",
+				Optional:            true,
+			},
+			"ignore_external_roles": schema.BoolAttribute{
+				MarkdownDescription: "Whether to leave roles that are not defined in this resource untouched, e.g. roles managed by `argocd_project_role`. By default such roles are removed from the project. Roles removed from this resource are deleted in either case.",
+				Optional:            true,
+			},
+			"ignore_external_sync_windows": schema.BoolAttribute{
+				MarkdownDescription: "Whether to leave sync windows that are not defined in this resource untouched, e.g. sync windows managed by `argocd_project_sync_window`. By default such sync windows are removed from the project. Sync windows removed from this resource are deleted in either case.",
+				Optional:            true,
+			},
 		},
 		Blocks: projectSchemaBlocks(),
 	}
@@ -124,6 +137,8 @@ func (r *projectResource) Create(ctx context.Context, req resource.CreateRequest
 	projectMutex.Lock()
 	defer projectMutex.Unlock()
 
+	adopted := false
+
 	// Check if project already exists
 	p, err := r.si.ProjectClient.Get(ctx, &project.ProjectQuery{
 		Name: projectName,
@@ -134,6 +149,17 @@ func (r *projectResource) Create(ctx context.Context, req resource.CreateRequest
 	} else if p != nil {
 		switch p.DeletionTimestamp {
 		case nil:
+			// Without adoption, ArgoCD only accepts the creation of a project
+			// identical to the existing one
+			adopted = data.AdoptExisting.ValueBool()
+
+			if adopted && data.IgnoreExternalRoles.ValueBool() {
+				spec.Roles = mergeExternalRoles(spec.Roles, p.Spec.Roles, nil)
+			}
+
+			if adopted && data.IgnoreExternalSyncWindows.ValueBool() {
+				spec.SyncWindows = mergeExternalSyncWindows(spec.SyncWindows, p.Spec.SyncWindows, nil)
+			}
 		default:
 			// Pre-existing project is still in Kubernetes soft deletion queue
 			if p.DeletionGracePeriodSeconds != nil {
@@ -148,11 +174,11 @@ func (r *projectResource) Create(ctx context.Context, req resource.CreateRequest
 			ObjectMeta: objectMeta,
 			Spec:       spec,
 		},
-		Upsert: false,
+		Upsert: adopted,
 	})
 
 	if err != nil {
-		resp.Diagnostics.Append(diagnostics.ArgoCDAPIError("create", "project", projectName, err)...)
+		resp.Diagnostics.Append(diagnostics.CreateError("project", projectName, err)...)
 		return
 	} else if p == nil {
 		resp.Diagnostics.AddError(
@@ -163,11 +189,26 @@ func (r *projectResource) Create(ctx context.Context, req resource.CreateRequest
 		return
 	}
 
+	if adopted {
+		resp.Diagnostics.Append(diagnostics.Adopted("project", projectName)...)
+	}
+
 	tflog.Trace(ctx, fmt.Sprintf("created project %s", projectName))
 
 	// Parse response and store state
 	projectData := newProject(p)
 	projectData.ID = types.StringValue(projectName)
+	projectData.AdoptExisting = data.AdoptExisting
+	projectData.IgnoreExternalRoles = data.IgnoreExternalRoles
+	projectData.IgnoreExternalSyncWindows = data.IgnoreExternalSyncWindows
+
+	if data.IgnoreExternalRoles.ValueBool() {
+		projectData.Spec[0].Role = withoutExternalRoles(data.Spec[0].Role, projectData.Spec[0].Role)
+	}
+
+	if data.IgnoreExternalSyncWindows.ValueBool() {
+		projectData.Spec[0].SyncWindow = withoutExternalSyncWindows(data.Spec[0].SyncWindow, projectData.Spec[0].SyncWindow)
+	}
 
 	// Preserve empty lists from plan that ArgoCD might have normalized to null (issue #788)
 	preserveEmptyLists(&data.Spec[0], &projectData.Spec[0])
@@ -309,6 +350,15 @@ func (r *projectResource) readUnsafe(ctx context.Context, data projectModel, pla
 	// Save updated data into Terraform state
 	apiData := newProject(p)
 	apiData.ID = types.StringValue(projectName)
+	apiData.AdoptExisting = data.AdoptExisting
+	apiData.IgnoreExternalRoles = data.IgnoreExternalRoles
+	apiData.IgnoreExternalSyncWindows = data.IgnoreExternalSyncWindows
+
+	if plan != nil {
+		apiData.AdoptExisting = plan.AdoptExisting
+		apiData.IgnoreExternalRoles = plan.IgnoreExternalRoles
+		apiData.IgnoreExternalSyncWindows = plan.IgnoreExternalSyncWindows
+	}
 
 	// Preserve empty lists from prior state/plan that ArgoCD might have normalized to null (issue #788)
 	// Use plan if provided (during Update), otherwise use prior state (during Read)
@@ -317,6 +367,16 @@ func (r *projectResource) readUnsafe(ctx context.Context, data projectModel, pla
 		if plan != nil && len(plan.Spec) > 0 {
 			sourceModel = &plan.Spec[0]
 		}
+
+		// Roles and sync windows managed outside of this resource would otherwise show up as drift
+		if apiData.IgnoreExternalRoles.ValueBool() {
+			apiData.Spec[0].Role = withoutExternalRoles(sourceModel.Role, apiData.Spec[0].Role)
+		}
+
+		if apiData.IgnoreExternalSyncWindows.ValueBool() {
+			apiData.Spec[0].SyncWindow = withoutExternalSyncWindows(sourceModel.SyncWindow, apiData.Spec[0].SyncWindow)
+		}
+
 		preserveEmptyLists(sourceModel, &apiData.Spec[0])
 	}
 
@@ -324,11 +384,14 @@ func (r *projectResource) readUnsafe(ctx context.Context, data projectModel, pla
 }
 
 func (r *projectResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var data projectModel
+	var data, state projectModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
 
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
 	// Initialize API clients
 	resp.Diagnostics.Append(r.si.InitClients(ctx)...)
 
@@ -411,6 +474,26 @@ func (r *projectResource) Update(ctx context.Context, req resource.UpdateRequest
 		}
 	}
 
+	// Roles and sync windows managed by this resource and removed from the
+	// configuration are not external and are deleted
+	if data.IgnoreExternalRoles.ValueBool() {
+		var managed []projectRoleModel
+		if len(state.Spec) > 0 {
+			managed = state.Spec[0].Role
+		}
+
+		spec.Roles = mergeExternalRoles(spec.Roles, p.Spec.Roles, managed)
+	}
+
+	if data.IgnoreExternalSyncWindows.ValueBool() {
+		var managed []syncWindowModel
+		if len(state.Spec) > 0 {
+			managed = state.Spec[0].SyncWindow
+		}
+
+		spec.SyncWindows = mergeExternalSyncWindows(spec.SyncWindows, p.Spec.SyncWindows, managed)
+	}
+
 	// Update project
 	projectRequest := &project.ProjectUpdateRequest{
 		Project: &v1alpha1.AppProject{
@@ -520,6 +603,86 @@ func (r *projectResource) ImportState(ctx context.Context, req resource.ImportSt
 	resp.Diagnostics.Append(resp.State.Set(ctx, projectData)...)
 }
 
+// mergeExternalRoles appends the existing roles that are neither part of the
+// configuration nor previously managed by the resource to the configured roles.
+func mergeExternalRoles(roles, existing []v1alpha1.ProjectRole, managed []projectRoleModel) []v1alpha1.ProjectRole {
+	for _, e := range existing {
+		if slices.ContainsFunc(roles, func(r v1alpha1.ProjectRole) bool { return r.Name == e.Name }) {
+			continue
+		}
+
+		if slices.ContainsFunc(managed, func(m projectRoleModel) bool { return m.Name.ValueString() == e.Name }) {
+			continue
+		}
+
+		roles = append(roles, e)
+	}
+
+	return roles
+}
+
+// withoutExternalRoles removes the roles that are not part of the
+// configuration from the roles read from ArgoCD.
+func withoutExternalRoles(configured, roles []projectRoleModel) []projectRoleModel {
+	var result []projectRoleModel
+
+	for _, r := range roles {
+		if slices.ContainsFunc(configured, func(c projectRoleModel) bool { return c.Name.Equal(r.Name) }) {
+			result = append(result, r)
+		}
+	}
+
+	return result
+}
+
+// mergeExternalSyncWindows appends the existing sync windows that are neither
+// part of the configuration nor previously managed by the resource to the
+// configured sync windows. Sync windows are matched by kind, schedule, duration
+// and targets.
+func mergeExternalSyncWindows(windows, existing v1alpha1.SyncWindows, managed []syncWindowModel) v1alpha1.SyncWindows {
+	for _, e := range existing {
+		if slices.ContainsFunc(windows, func(w *v1alpha1.SyncWindow) bool { return sameSyncWindow(w, e) }) {
+			continue
+		}
+
+		if slices.ContainsFunc(managed, func(m syncWindowModel) bool { return m.matches(e) }) {
+			continue
+		}
+
+		windows = append(windows, e)
+	}
+
+	return windows
+}
+
+// withoutExternalSyncWindows removes the sync windows that are not part of the
+// configuration from the sync windows read from ArgoCD.
+func withoutExternalSyncWindows(configured, windows []syncWindowModel) []syncWindowModel {
+	var result []syncWindowModel
+
+	for _, w := range windows {
+		if slices.ContainsFunc(configured, func(c syncWindowModel) bool {
+			return c.Kind.Equal(w.Kind) && c.Schedule.Equal(w.Schedule) && c.Duration.Equal(w.Duration) &&
+				equalStringModels(c.Applications, w.Applications) &&
+				equalStringModels(c.Clusters, w.Clusters) &&
+				equalStringModels(c.Namespaces, w.Namespaces)
+		}) {
+			result = append(result, w)
+		}
+	}
+
+	return result
+}
+
+// sameSyncWindow reports whether both sync windows have the same kind,
+// schedule, duration and targets.
+func sameSyncWindow(a, b *v1alpha1.SyncWindow) bool {
+	return a.Kind == b.Kind && a.Schedule == b.Schedule && a.Duration == b.Duration &&
+		slices.Equal(a.Applications, b.Applications) &&
+		slices.Equal(a.Clusters, b.Clusters) &&
+		slices.Equal(a.Namespaces, b.Namespaces)
+}
+
 // expandProject converts the Terraform model to ArgoCD API types
 func expandProject(ctx context.Context, data *projectModel) (metav1.ObjectMeta, v1alpha1.AppProjectSpec, diag.Diagnostics) {
 	var diags diag.Diagnostics
@@ -0,0 +1,291 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/argoproj-labs/terraform-provider-argocd/internal/diagnostics"
+	argocdSync "github.com/argoproj-labs/terraform-provider-argocd/internal/sync"
+	"github.com/argoproj/argo-cd/v3/pkg/apiclient/project"
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &projectRoleResource{}
+var _ resource.ResourceWithImportState = &projectRoleResource{}
+
+func NewProjectRoleResource() resource.Resource {
+	return &projectRoleResource{}
+}
+
+type projectRoleResource struct {
+	si *ServerInterface
+}
+
+func (r *projectRoleResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_project_role"
+}
+
+func (r *projectRoleResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a single [role](https://argo-cd.readthedocs.io/en/stable/user-guide/projects/#project-roles) of an existing ArgoCD project.\n\n~> **Note** Roles of a project managed by `argocd_project` are overwritten by that resource unless `ignore_external_roles` is set on it. Do not manage the same role both inline and with this resource.\n",
+		Attributes:          projectRoleSchemaAttributes(),
+	}
+}
+
+func (r *projectRoleResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	si, ok := req.ProviderData.(*ServerInterface)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Data Type",
+			fmt.Sprintf("Expected *ServerInterface, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.si = si
+}
+
+func (r *projectRoleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data projectRoleResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	// Initialize API clients
+	resp.Diagnostics.Append(r.si.InitClients(ctx)...)
+
+	// Check for errors before proceeding
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectName := data.Project.ValueString()
+	roleName := data.Name.ValueString()
+
+	p, diags := modifyProject(ctx, r.si, projectName, func(p *v1alpha1.AppProject) diag.Diagnostics {
+		if _, _, err := p.GetRoleByName(roleName); err == nil {
+			return diag.Diagnostics{diag.NewErrorDiagnostic(
+				"Project Role Already Exists",
+				fmt.Sprintf("role %s already exists in project %s", roleName, projectName),
+			)}
+		}
+
+		var role v1alpha1.ProjectRole
+
+		data.applyTo(&role)
+		p.Spec.Roles = append(p.Spec.Roles, role)
+
+		return nil
+	})
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("created role %s in project %s", roleName, projectName))
+
+	role, _, err := p.GetRoleByName(roleName)
+	if err != nil {
+		resp.Diagnostics.Append(diagnostics.Error(fmt.Sprintf("role %s could not be found in project %s after creation", roleName, projectName), err)...)
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, newProjectRole(projectName, role, &data))...)
+}
+
+func (r *projectRoleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data projectRoleResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	// Initialize API clients
+	resp.Diagnostics.Append(r.si.InitClients(ctx)...)
+
+	// Check for errors before proceeding
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectName := data.Project.ValueString()
+	roleName := data.Name.ValueString()
+
+	// Get or create project mutex safely
+	projectMutex := argocdSync.GetProjectMutex(projectName)
+	projectMutex.RLock()
+	defer projectMutex.RUnlock()
+
+	p, err := r.si.ProjectClient.Get(ctx, &project.ProjectQuery{
+		Name: projectName,
+	})
+	if err != nil {
+		if strings.Contains(err.Error(), "NotFound") {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.Append(diagnostics.ArgoCDAPIError("read", "project", projectName, err)...)
+
+		return
+	}
+
+	role, _, err := p.GetRoleByName(roleName)
+	if err != nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, newProjectRole(projectName, role, &data))...)
+}
+
+func (r *projectRoleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data projectRoleResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	// Initialize API clients
+	resp.Diagnostics.Append(r.si.InitClients(ctx)...)
+
+	// Check for errors before proceeding
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectName := data.Project.ValueString()
+	roleName := data.Name.ValueString()
+
+	p, diags := modifyProject(ctx, r.si, projectName, func(p *v1alpha1.AppProject) diag.Diagnostics {
+		_, i, err := p.GetRoleByName(roleName)
+		if err != nil {
+			return diagnostics.Error(fmt.Sprintf("role %s could not be found in project %s", roleName, projectName), err)
+		}
+
+		// Existing JWT tokens are kept as they are managed by argocd_project_token
+		data.applyTo(&p.Spec.Roles[i])
+
+		return nil
+	})
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("updated role %s in project %s", roleName, projectName))
+
+	role, _, err := p.GetRoleByName(roleName)
+	if err != nil {
+		resp.Diagnostics.Append(diagnostics.Error(fmt.Sprintf("role %s could not be found in project %s after update", roleName, projectName), err)...)
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, newProjectRole(projectName, role, &data))...)
+}
+
+func (r *projectRoleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data projectRoleResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	// Initialize API clients
+	resp.Diagnostics.Append(r.si.InitClients(ctx)...)
+
+	// Check for errors before proceeding
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectName := data.Project.ValueString()
+	roleName := data.Name.ValueString()
+
+	_, diags := modifyProject(ctx, r.si, projectName, func(p *v1alpha1.AppProject) diag.Diagnostics {
+		roles := make([]v1alpha1.ProjectRole, 0, len(p.Spec.Roles))
+
+		for _, role := range p.Spec.Roles {
+			if role.Name != roleName {
+				roles = append(roles, role)
+			}
+		}
+
+		p.Spec.Roles = roles
+
+		return nil
+	})
+
+	// The role is gone if the whole project has been deleted already
+	if diags.HasError() && strings.Contains(diags.Errors()[0].Detail(), "NotFound") {
+		return
+	}
+
+	resp.Diagnostics.Append(diags...)
+
+	tflog.Trace(ctx, fmt.Sprintf("deleted role %s from project %s", roleName, projectName))
+}
+
+func (r *projectRoleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Import format: project:role
+	parts := strings.Split(req.ID, ":")
+	if len(parts) != 2 {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			"Import ID must be in the format 'project:role'",
+		)
+
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), parts[1])...)
+}
+
+// modifyProject performs a read-modify-write of the given project while
+// holding the project mutex, so that resources which manage parts of the same
+// project do not overwrite each other's changes.
+func modifyProject(ctx context.Context, si *ServerInterface, projectName string, modify func(p *v1alpha1.AppProject) diag.Diagnostics) (*v1alpha1.AppProject, diag.Diagnostics) {
+	// Get or create project mutex safely
+	projectMutex := argocdSync.GetProjectMutex(projectName)
+	projectMutex.Lock()
+	defer projectMutex.Unlock()
+
+	p, err := si.ProjectClient.Get(ctx, &project.ProjectQuery{
+		Name: projectName,
+	})
+	if err != nil {
+		return nil, diagnostics.ArgoCDAPIError("get", "project", projectName, err)
+	}
+
+	if diags := modify(p); diags.HasError() {
+		return nil, diags
+	}
+
+	// The ResourceVersion of the retrieved project guards against changes made
+	// outside of this provider in the meantime
+	p, err = si.ProjectClient.Update(ctx, &project.ProjectUpdateRequest{
+		Project: p,
+	})
+	if err != nil {
+		return nil, diagnostics.ArgoCDAPIError("update", "project", projectName, err)
+	}
+
+	return p, nil
+}
@@ -0,0 +1,184 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccArgoCDProjectRole(t *testing.T) {
+	name := acctest.RandomWithPrefix("test-acc")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccArgoCDProjectRole(name, "reader", "get", `["team-a"]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("argocd_project_role.external", "id", name+":external"),
+					resource.TestCheckResourceAttr("argocd_project_role.external", "description", "reader"),
+					resource.TestCheckResourceAttr("argocd_project_role.external", "policies.0", fmt.Sprintf("p, proj:%[1]s:external, applications, get, %[1]s/*, allow", name)),
+					resource.TestCheckResourceAttr("argocd_project_role.external", "groups.0", "team-a"),
+					resource.TestCheckResourceAttr("argocd_project.foo", "spec.0.role.#", "1"),
+					resource.TestCheckResourceAttr("argocd_project.foo", "spec.0.role.0.name", "inline"),
+				),
+			},
+			{
+				Config: testAccArgoCDProjectRole(name, "syncer", "sync", `[]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("argocd_project_role.external", "description", "syncer"),
+					resource.TestCheckResourceAttr("argocd_project_role.external", "policies.0", fmt.Sprintf("p, proj:%[1]s:external, applications, sync, %[1]s/*, allow", name)),
+					resource.TestCheckResourceAttr("argocd_project_role.external", "groups.#", "0"),
+					resource.TestCheckResourceAttr("argocd_project.foo", "spec.0.role.#", "1"),
+				),
+			},
+			{
+				ResourceName:      "argocd_project_role.external",
+				ImportState:       true,
+				ImportStateId:     name + ":external",
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccArgoCDProjectRole_AlreadyExists(t *testing.T) {
+	name := acctest.RandomWithPrefix("test-acc")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccArgoCDProjectRole(name, "reader", "get", `[]`) + fmt.Sprintf(`
+resource "argocd_project_role" "duplicate" {
+  project  = argocd_project.foo.metadata[0].name
+  name     = "inline"
+  policies = ["p, proj:%[1]s:inline, applications, get, %[1]s/*, allow"]
+}
+`, name),
+				ExpectError: regexp.MustCompile("role inline already exists in project"),
+			},
+		},
+	})
+}
+
+func TestAccArgoCDProjectRole_RemoveInlineRole(t *testing.T) {
+	name := acctest.RandomWithPrefix("test-acc")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccArgoCDProjectRole(name, "reader", "get", `[]`),
+				Check:  testAccCheckArgoCDProjectValues(t, name, projectRoleNames, "inline", "external"),
+			},
+			{
+				// The inline role is deleted while the external role is left untouched
+				Config: testAccArgoCDProjectRoleWithoutInline(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("argocd_project.foo", "spec.0.role.#", "0"),
+					testAccCheckArgoCDProjectValues(t, name, projectRoleNames, "external"),
+				),
+			},
+		},
+	})
+}
+
+func TestMergeExternalRoles(t *testing.T) {
+	t.Parallel()
+
+	existing := []v1alpha1.ProjectRole{{Name: "inline"}, {Name: "removed"}, {Name: "external"}}
+	managed := []projectRoleModel{{Name: types.StringValue("inline")}, {Name: types.StringValue("removed")}}
+
+	roles := mergeExternalRoles([]v1alpha1.ProjectRole{{Name: "inline"}}, existing, managed)
+
+	assert.Equal(t, []v1alpha1.ProjectRole{{Name: "inline"}, {Name: "external"}}, roles)
+}
+
+func TestNewProjectRole_emptyLists(t *testing.T) {
+	t.Parallel()
+
+	role := &v1alpha1.ProjectRole{Name: "reader"}
+
+	// Empty lists are read back as such rather than as null lists
+	m := newProjectRole("foo", role, &projectRoleResourceModel{Policies: []types.String{}, Groups: []types.String{}})
+	assert.Equal(t, []types.String{}, m.Policies)
+	assert.Equal(t, []types.String{}, m.Groups)
+
+	m = newProjectRole("foo", role, &projectRoleResourceModel{})
+	assert.Nil(t, m.Policies)
+	assert.Nil(t, m.Groups)
+}
+
+func testAccArgoCDProjectRoleWithoutInline(name string) string {
+	return fmt.Sprintf(`
+resource "argocd_project" "foo" {
+  metadata {
+    name      = "%[1]s"
+    namespace = "argocd"
+  }
+
+  ignore_external_roles = true
+
+  spec {
+    source_repos = ["*"]
+
+    destination {
+      server    = "https://kubernetes.default.svc"
+      namespace = "default"
+    }
+  }
+}
+
+resource "argocd_project_role" "external" {
+  project     = argocd_project.foo.metadata[0].name
+  name        = "external"
+  description = "reader"
+  policies    = ["p, proj:%[1]s:external, applications, get, %[1]s/*, allow"]
+}
+`, name)
+}
+
+func testAccArgoCDProjectRole(name, description, action, groups string) string {
+	return fmt.Sprintf(`
+resource "argocd_project" "foo" {
+  metadata {
+    name      = "%[1]s"
+    namespace = "argocd"
+  }
+
+  ignore_external_roles = true
+
+  spec {
+    source_repos = ["*"]
+
+    destination {
+      server    = "https://kubernetes.default.svc"
+      namespace = "default"
+    }
+
+    role {
+      name     = "inline"
+      policies = ["p, proj:%[1]s:inline, applications, get, %[1]s/*, allow"]
+    }
+  }
+}
+
+resource "argocd_project_role" "external" {
+  project     = argocd_project.foo.metadata[0].name
+  name        = "external"
+  description = "%[2]s"
+  policies    = ["p, proj:%[1]s:external, applications, %[3]s, %[1]s/*, allow"]
+  groups      = %[4]s
+}
+`, name, description, action, groups)
+}
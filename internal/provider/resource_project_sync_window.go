@@ -0,0 +1,329 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/argoproj-labs/terraform-provider-argocd/internal/diagnostics"
+	argocdSync "github.com/argoproj-labs/terraform-provider-argocd/internal/sync"
+	"github.com/argoproj/argo-cd/v3/pkg/apiclient/project"
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &projectSyncWindowResource{}
+var _ resource.ResourceWithImportState = &projectSyncWindowResource{}
+
+func NewProjectSyncWindowResource() resource.Resource {
+	return &projectSyncWindowResource{}
+}
+
+type projectSyncWindowResource struct {
+	si *ServerInterface
+}
+
+func (r *projectSyncWindowResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_project_sync_window"
+}
+
+func (r *projectSyncWindowResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a single [sync window](https://argo-cd.readthedocs.io/en/stable/user-guide/sync_windows/) of an existing ArgoCD project. A sync window is identified by its `kind`, `schedule`, `duration` and targets, so several sync windows with the same schedule may target different applications, clusters or namespaces.\n\n~> **Note** Sync windows of a project managed by `argocd_project` are overwritten by that resource unless `ignore_external_sync_windows` is set on it. Do not manage the same sync window both inline and with this resource.\n",
+		Attributes:          projectSyncWindowSchemaAttributes(),
+	}
+}
+
+func (r *projectSyncWindowResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	si, ok := req.ProviderData.(*ServerInterface)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Data Type",
+			fmt.Sprintf("Expected *ServerInterface, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.si = si
+}
+
+func (r *projectSyncWindowResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data projectSyncWindowModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	// Initialize API clients
+	resp.Diagnostics.Append(r.si.InitClients(ctx)...)
+
+	// Check for errors before proceeding
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectName := data.Project.ValueString()
+	id := syncWindowID(projectName, data.Kind.ValueString(), data.Schedule.ValueString(), data.Duration.ValueString())
+
+	_, diags := modifyProject(ctx, r.si, projectName, func(p *v1alpha1.AppProject) diag.Diagnostics {
+		if slices.ContainsFunc(p.Spec.SyncWindows, data.matches) {
+			return diag.Diagnostics{diag.NewErrorDiagnostic(
+				"Sync Window Already Exists",
+				fmt.Sprintf("sync window %s already exists in project %s", id, projectName),
+			)}
+		}
+
+		p.Spec.SyncWindows = append(p.Spec.SyncWindows, data.toSyncWindow())
+
+		return nil
+	})
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("created sync window %s", id))
+
+	data.ID = types.StringValue(id)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *projectSyncWindowResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data projectSyncWindowModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	// Initialize API clients
+	resp.Diagnostics.Append(r.si.InitClients(ctx)...)
+
+	// Check for errors before proceeding
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectName := data.Project.ValueString()
+
+	// Get or create project mutex safely
+	projectMutex := argocdSync.GetProjectMutex(projectName)
+	projectMutex.RLock()
+	defer projectMutex.RUnlock()
+
+	p, err := r.si.ProjectClient.Get(ctx, &project.ProjectQuery{
+		Name: projectName,
+	})
+	if err != nil {
+		if strings.Contains(err.Error(), "NotFound") {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.Append(diagnostics.ArgoCDAPIError("read", "project", projectName, err)...)
+
+		return
+	}
+
+	i := slices.IndexFunc(p.Spec.SyncWindows, data.matches)
+	if i == -1 {
+		// The targets of the sync window may have been changed outside of
+		// Terraform, which is only detected when no other window has the same
+		// kind, schedule and duration
+		i = uniqueSyncWindowIndex(p.Spec.SyncWindows, data.matchesSchedule)
+	}
+
+	if i == -1 {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, newProjectSyncWindow(projectName, p.Spec.SyncWindows[i], &data))...)
+}
+
+func (r *projectSyncWindowResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state projectSyncWindowModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	// Initialize API clients
+	resp.Diagnostics.Append(r.si.InitClients(ctx)...)
+
+	// Check for errors before proceeding
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectName := data.Project.ValueString()
+	id := data.ID.ValueString()
+
+	_, diags := modifyProject(ctx, r.si, projectName, func(p *v1alpha1.AppProject) diag.Diagnostics {
+		// The window is looked up by its prior targets, which may be updated
+		i := slices.IndexFunc(p.Spec.SyncWindows, state.matches)
+		if i == -1 {
+			return diag.Diagnostics{diag.NewErrorDiagnostic(
+				"Sync Window Not Found",
+				fmt.Sprintf("sync window %s could not be found in project %s", id, projectName),
+			)}
+		}
+
+		p.Spec.SyncWindows[i] = data.toSyncWindow()
+
+		return nil
+	})
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("updated sync window %s", id))
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *projectSyncWindowResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data projectSyncWindowModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	// Initialize API clients
+	resp.Diagnostics.Append(r.si.InitClients(ctx)...)
+
+	// Check for errors before proceeding
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectName := data.Project.ValueString()
+
+	_, diags := modifyProject(ctx, r.si, projectName, func(p *v1alpha1.AppProject) diag.Diagnostics {
+		// Only a single window is removed, as identical windows may be managed
+		// elsewhere
+		if i := slices.IndexFunc(p.Spec.SyncWindows, data.matches); i != -1 {
+			p.Spec.SyncWindows = slices.Delete(p.Spec.SyncWindows, i, i+1)
+		}
+
+		return nil
+	})
+
+	// The sync window is gone if the whole project has been deleted already
+	if diags.HasError() && strings.Contains(diags.Errors()[0].Detail(), "NotFound") {
+		return
+	}
+
+	resp.Diagnostics.Append(diags...)
+
+	tflog.Trace(ctx, fmt.Sprintf("deleted sync window %s", data.ID.ValueString()))
+}
+
+func (r *projectSyncWindowResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Import format: project:kind:schedule:duration[:index], none of the values
+	// containing a colon
+	parts := strings.Split(req.ID, ":")
+	if len(parts) != 4 && len(parts) != 5 {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			"Import ID must be in the format 'project:kind:schedule:duration' or 'project:kind:schedule:duration:index'",
+		)
+
+		return
+	}
+
+	index := -1
+
+	if len(parts) == 5 {
+		var err error
+		if index, err = strconv.Atoi(parts[4]); err != nil || index < 0 {
+			resp.Diagnostics.AddError(
+				"Invalid Import ID",
+				fmt.Sprintf("index %s of the sync window must be a non-negative integer", parts[4]),
+			)
+
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(r.si.InitClients(ctx)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectName := parts[0]
+	data := projectSyncWindowModel{
+		Kind:     types.StringValue(parts[1]),
+		Schedule: types.StringValue(parts[2]),
+		Duration: types.StringValue(parts[3]),
+	}
+
+	p, err := r.si.ProjectClient.Get(ctx, &project.ProjectQuery{
+		Name: projectName,
+	})
+	if err != nil {
+		resp.Diagnostics.Append(diagnostics.ArgoCDAPIError("read", "project", projectName, err)...)
+		return
+	}
+
+	var windows v1alpha1.SyncWindows
+
+	for _, w := range p.Spec.SyncWindows {
+		if data.matchesSchedule(w) {
+			windows = append(windows, w)
+		}
+	}
+
+	id := syncWindowID(projectName, parts[1], parts[2], parts[3])
+
+	switch {
+	case len(windows) == 0 || index >= len(windows):
+		resp.Diagnostics.AddError(
+			"Cannot import non-existent remote object",
+			fmt.Sprintf("sync window %s does not exist in project %s", req.ID, projectName),
+		)
+
+		return
+	case index == -1 && len(windows) > 1:
+		resp.Diagnostics.AddError(
+			"Ambiguous Import ID",
+			fmt.Sprintf("project %s has %d sync windows %s targeting different applications, clusters or namespaces, append the index of the sync window to import, e.g. '%s:0'", projectName, len(windows), id, id),
+		)
+
+		return
+	case index == -1:
+		index = 0
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, newProjectSyncWindow(projectName, windows[index], &data))...)
+}
+
+// uniqueSyncWindowIndex returns the index of the only sync window matching f,
+// or -1 if there is none or several.
+func uniqueSyncWindowIndex(windows v1alpha1.SyncWindows, f func(*v1alpha1.SyncWindow) bool) int {
+	i := slices.IndexFunc(windows, f)
+	if i == -1 || slices.ContainsFunc(windows[i+1:], f) {
+		return -1
+	}
+
+	return i
+}
@@ -0,0 +1,315 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccArgoCDProjectSyncWindow(t *testing.T) {
+	name := acctest.RandomWithPrefix("test-acc")
+	id := name + ":deny:0 22 * * *:1h"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccArgoCDProjectSyncWindow(name, "UTC", false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("argocd_project_sync_window.freeze", "id", id),
+					resource.TestCheckResourceAttr("argocd_project_sync_window.freeze", "timezone", "UTC"),
+					resource.TestCheckResourceAttr("argocd_project_sync_window.freeze", "manual_sync", "false"),
+					resource.TestCheckResourceAttr("argocd_project_sync_window.freeze", "use_and_operator", "false"),
+					resource.TestCheckResourceAttr("argocd_project_sync_window.freeze", "applications.0", "*"),
+					resource.TestCheckResourceAttr("argocd_project_sync_window.freeze", "namespaces.#", "0"),
+					resource.TestCheckResourceAttr("argocd_project.foo", "spec.0.sync_window.#", "1"),
+					resource.TestCheckResourceAttr("argocd_project.foo", "spec.0.sync_window.0.kind", "allow"),
+				),
+			},
+			{
+				Config: testAccArgoCDProjectSyncWindow(name, "Europe/Amsterdam", true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("argocd_project_sync_window.freeze", "id", id),
+					resource.TestCheckResourceAttr("argocd_project_sync_window.freeze", "timezone", "Europe/Amsterdam"),
+					resource.TestCheckResourceAttr("argocd_project_sync_window.freeze", "manual_sync", "true"),
+					resource.TestCheckResourceAttr("argocd_project.foo", "spec.0.sync_window.#", "1"),
+				),
+			},
+			{
+				ResourceName:            "argocd_project_sync_window.freeze",
+				ImportState:             true,
+				ImportStateId:           id,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"namespaces"},
+			},
+		},
+	})
+}
+
+func TestAccArgoCDProjectSyncWindow_AlreadyExists(t *testing.T) {
+	name := acctest.RandomWithPrefix("test-acc")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccArgoCDProjectSyncWindow(name, "UTC", false) + `
+resource "argocd_project_sync_window" "duplicate" {
+  project      = argocd_project.foo.metadata[0].name
+  kind         = "allow"
+  schedule     = "0 8 * * *"
+  duration     = "10h"
+  applications = ["*"]
+}
+`,
+				ExpectError: regexp.MustCompile("already exists in project"),
+			},
+		},
+	})
+}
+
+func TestAccArgoCDProjectSyncWindow_InvalidSchedule(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "argocd_project_sync_window" "invalid" {
+  project  = "default"
+  kind     = "deny"
+  schedule = "not a schedule"
+  duration = "1h"
+}
+`,
+				ExpectError: regexp.MustCompile("Invalid Cron Schedule"),
+			},
+		},
+	})
+}
+
+func TestAccArgoCDProjectSyncWindow_InlineChanges(t *testing.T) {
+	name := acctest.RandomWithPrefix("test-acc")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccArgoCDProjectSyncWindowInline(name, "0 8 * * *"),
+				Check:  testAccCheckArgoCDProjectValues(t, name, projectSyncWindowSchedules, "0 8 * * *", "0 22 * * *"),
+			},
+			{
+				// The previous schedule of the inline sync window is not kept as an external one
+				Config: testAccArgoCDProjectSyncWindowInline(name, "0 9 * * *"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("argocd_project.foo", "spec.0.sync_window.#", "1"),
+					testAccCheckArgoCDProjectValues(t, name, projectSyncWindowSchedules, "0 9 * * *", "0 22 * * *"),
+				),
+			},
+			{
+				// The inline sync window is deleted while the external one is left untouched
+				Config: testAccArgoCDProjectSyncWindowInline(name, ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("argocd_project.foo", "spec.0.sync_window.#", "0"),
+					testAccCheckArgoCDProjectValues(t, name, projectSyncWindowSchedules, "0 22 * * *"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccArgoCDProjectSyncWindow_SameSchedule(t *testing.T) {
+	name := acctest.RandomWithPrefix("test-acc")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccArgoCDProjectSyncWindowSameSchedule(name, "foo", "bar"),
+				Check:  testAccCheckArgoCDProjectValues(t, name, projectSyncWindowApplications, "foo", "bar"),
+			},
+			{
+				ResourceName:      "argocd_project_sync_window.bar",
+				ImportState:       true,
+				ImportStateId:     name + ":deny:0 22 * * *:1h",
+				ImportStateVerify: true,
+				ExpectError:       regexp.MustCompile("append the index of the sync window to import"),
+			},
+			{
+				// Only the sync window with the same targets is removed
+				Config: testAccArgoCDProjectSyncWindowSameSchedule(name, "bar"),
+				Check:  testAccCheckArgoCDProjectValues(t, name, projectSyncWindowApplications, "bar"),
+			},
+			{
+				ResourceName:      "argocd_project_sync_window.bar",
+				ImportState:       true,
+				ImportStateId:     name + ":deny:0 22 * * *:1h",
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestProjectSyncWindowModelMatches(t *testing.T) {
+	t.Parallel()
+
+	m := &projectSyncWindowModel{
+		Kind:         types.StringValue("deny"),
+		Schedule:     types.StringValue("0 22 * * *"),
+		Duration:     types.StringValue("1h"),
+		Applications: []types.String{types.StringValue("foo")},
+		Namespaces:   []types.String{},
+	}
+
+	foo := &v1alpha1.SyncWindow{Kind: "deny", Schedule: "0 22 * * *", Duration: "1h", Applications: []string{"foo"}}
+	bar := &v1alpha1.SyncWindow{Kind: "deny", Schedule: "0 22 * * *", Duration: "1h", Applications: []string{"bar"}}
+
+	assert.True(t, m.matches(foo))
+	assert.False(t, m.matches(bar))
+	assert.True(t, m.matchesSchedule(bar))
+
+	assert.Equal(t, -1, uniqueSyncWindowIndex(v1alpha1.SyncWindows{foo, bar}, m.matchesSchedule))
+	assert.Equal(t, 1, uniqueSyncWindowIndex(v1alpha1.SyncWindows{{Kind: "allow"}, bar}, m.matchesSchedule))
+}
+
+func TestMergeExternalSyncWindows(t *testing.T) {
+	t.Parallel()
+
+	inline := &v1alpha1.SyncWindow{Kind: "allow", Schedule: "0 9 * * *", Duration: "10h"}
+	previous := &v1alpha1.SyncWindow{Kind: "allow", Schedule: "0 8 * * *", Duration: "10h"}
+	external := &v1alpha1.SyncWindow{Kind: "deny", Schedule: "0 22 * * *", Duration: "1h"}
+
+	managed := []syncWindowModel{{Kind: types.StringValue("allow"), Schedule: types.StringValue("0 8 * * *"), Duration: types.StringValue("10h")}}
+
+	windows := mergeExternalSyncWindows(v1alpha1.SyncWindows{inline}, v1alpha1.SyncWindows{previous, external}, managed)
+
+	assert.Equal(t, v1alpha1.SyncWindows{inline, external}, windows)
+}
+
+func testAccArgoCDProjectSyncWindowInline(name, schedule string) string {
+	inline := ""
+	if schedule != "" {
+		inline = fmt.Sprintf(`
+    sync_window {
+      kind         = "allow"
+      applications = ["*"]
+      schedule     = "%s"
+      duration     = "10h"
+    }`, schedule)
+	}
+
+	return fmt.Sprintf(`
+resource "argocd_project" "foo" {
+  metadata {
+    name      = "%[1]s"
+    namespace = "argocd"
+  }
+
+  ignore_external_sync_windows = true
+
+  spec {
+    source_repos = ["*"]
+
+    destination {
+      server    = "https://kubernetes.default.svc"
+      namespace = "default"
+    }
+%[2]s
+  }
+}
+
+resource "argocd_project_sync_window" "freeze" {
+  project      = argocd_project.foo.metadata[0].name
+  kind         = "deny"
+  schedule     = "0 22 * * *"
+  duration     = "1h"
+  applications = ["*"]
+}
+`, name, inline)
+}
+
+func testAccArgoCDProjectSyncWindow(name, timezone string, manualSync bool) string {
+	return fmt.Sprintf(`
+resource "argocd_project" "foo" {
+  metadata {
+    name      = "%[1]s"
+    namespace = "argocd"
+  }
+
+  ignore_external_sync_windows = true
+
+  spec {
+    source_repos = ["*"]
+
+    destination {
+      server    = "https://kubernetes.default.svc"
+      namespace = "default"
+    }
+
+    sync_window {
+      kind         = "allow"
+      applications = ["*"]
+      schedule     = "0 8 * * *"
+      duration     = "10h"
+    }
+  }
+}
+
+resource "argocd_project_sync_window" "freeze" {
+  project      = argocd_project.foo.metadata[0].name
+  kind         = "deny"
+  schedule     = "0 22 * * *"
+  duration     = "1h"
+  timezone     = "%[2]s"
+  applications = ["*"]
+  namespaces   = []
+  manual_sync  = %[3]t
+}
+`, name, timezone, manualSync)
+}
+
+func testAccArgoCDProjectSyncWindowSameSchedule(name string, applications ...string) string {
+	config := fmt.Sprintf(`
+resource "argocd_project" "foo" {
+  metadata {
+    name      = "%s"
+    namespace = "argocd"
+  }
+
+  ignore_external_sync_windows = true
+
+  spec {
+    source_repos = ["*"]
+
+    destination {
+      server    = "https://kubernetes.default.svc"
+      namespace = "default"
+    }
+  }
+}
+`, name)
+
+	for _, a := range applications {
+		config += fmt.Sprintf(`
+resource "argocd_project_sync_window" "%[1]s" {
+  project      = argocd_project.foo.metadata[0].name
+  kind         = "deny"
+  schedule     = "0 22 * * *"
+  duration     = "1h"
+  applications = ["%[1]s"]
+}
+`, a)
+	}
+
+	return config
+}
@@ -6,9 +6,14 @@ import (
 	"testing"
 
 	"github.com/argoproj-labs/terraform-provider-argocd/internal/features"
+	"github.com/argoproj/argo-cd/v3/pkg/apiclient/project"
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 func TestAccArgoCDProject(t *testing.T) {
@@ -2345,3 +2350,121 @@ resource "argocd_project" "unknown_annotation" {
 }
 	`, value, name)
 }
+
+func TestAccArgoCDProject_AdoptExisting(t *testing.T) {
+	name := acctest.RandomWithPrefix("test-acc")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() {
+					si := testAccServerInterface(t)
+
+					_, err := si.ProjectClient.Create(t.Context(), &project.ProjectCreateRequest{
+						Project: &v1alpha1.AppProject{
+							ObjectMeta: metav1.ObjectMeta{
+								Name:      name,
+								Namespace: "argocd",
+							},
+							Spec: v1alpha1.AppProjectSpec{
+								Description: "bootstrapped",
+							},
+						},
+					})
+					if err != nil {
+						t.Fatalf("failed to create project %s: %s", name, err.Error())
+					}
+				},
+				Config:      testAccArgoCDProjectAdoptExisting(name, false),
+				ExpectError: regexp.MustCompile("project .* already exists"),
+			},
+			{
+				Config: testAccArgoCDProjectAdoptExisting(name, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"argocd_project.adopt_existing",
+						"spec.0.description",
+						"adopted",
+					),
+					resource.TestCheckResourceAttr(
+						"argocd_project.adopt_existing",
+						"adopt_existing",
+						"true",
+					),
+				),
+			},
+			{
+				ResourceName:            "argocd_project.adopt_existing",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"adopt_existing"},
+			},
+		},
+	})
+}
+
+func testAccArgoCDProjectAdoptExisting(name string, adopt bool) string {
+	return fmt.Sprintf(`
+resource "argocd_project" "adopt_existing" {
+  metadata {
+    name      = "%s"
+    namespace = "argocd"
+  }
+
+  spec {
+    description  = "adopted"
+    source_repos = ["*"]
+
+    destination {
+      server    = "https://kubernetes.default.svc"
+      namespace = "default"
+    }
+  }
+
+  adopt_existing = %t
+}
+`, name, adopt)
+}
+
+// testAccCheckArgoCDProjectValues checks the values extracted from the project
+// in ArgoCD, regardless of their order.
+func testAccCheckArgoCDProjectValues(t *testing.T, name string, values func(p *v1alpha1.AppProject) []string, expected ...string) resource.TestCheckFunc {
+	return func(_ *terraform.State) error {
+		p, err := testAccServerInterface(t).ProjectClient.Get(t.Context(), &project.ProjectQuery{Name: name})
+		if err != nil {
+			return err
+		}
+
+		if actual := values(p); !assert.ElementsMatch(t, expected, actual) {
+			return fmt.Errorf("unexpected values %v in project %s", actual, name)
+		}
+
+		return nil
+	}
+}
+
+func projectRoleNames(p *v1alpha1.AppProject) (names []string) {
+	for _, r := range p.Spec.Roles {
+		names = append(names, r.Name)
+	}
+
+	return names
+}
+
+func projectSyncWindowSchedules(p *v1alpha1.AppProject) (schedules []string) {
+	for _, w := range p.Spec.SyncWindows {
+		schedules = append(schedules, w.Schedule)
+	}
+
+	return schedules
+}
+
+func projectSyncWindowApplications(p *v1alpha1.AppProject) (applications []string) {
+	for _, w := range p.Spec.SyncWindows {
+		applications = append(applications, w.Applications...)
+	}
+
+	return applications
+}
@@ -89,6 +89,21 @@ func (r *repositoryResource) Create(ctx context.Context, req resource.CreateRequ
 		return
 	}
 
+	// Without adoption, ArgoCD only accepts the creation of a repository
+	// identical to the existing one
+	adopted := false
+
+	if data.AdoptExisting.ValueBool() {
+		existingRepo, diags := r.readRepository(ctx, repo.Repo, repo.Project)
+		resp.Diagnostics.Append(diags...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		adopted = existingRepo != nil
+	}
+
 	timeout := 2 * time.Minute
 
 	// Create repository with retry logic for SSH handshake issues
@@ -103,7 +118,7 @@ func (r *repositoryResource) Create(ctx context.Context, req resource.CreateRequ
 			ctx,
 			&repository.RepoCreateRequest{
 				Repo:   repo,
-				Upsert: false,
+				Upsert: adopted,
 			},
 		)
 
@@ -129,10 +144,14 @@ func (r *repositoryResource) Create(ctx context.Context, req resource.CreateRequ
 	})
 
 	if retryErr != nil {
-		resp.Diagnostics.Append(diagnostics.ArgoCDAPIError("create", "repository", repo.Repo, retryErr)...)
+		resp.Diagnostics.Append(diagnostics.CreateError("repository", repo.Repo, retryErr)...)
 		return
 	}
 
+	if adopted {
+		resp.Diagnostics.Append(diagnostics.Adopted("repository", repo.Repo)...)
+	}
+
 	tflog.Trace(ctx, fmt.Sprintf("created repository %s", createdRepo.Repo))
 
 	// Save data into Terraform state
@@ -6,6 +6,8 @@ import (
 	"testing"
 
 	"github.com/argoproj-labs/terraform-provider-argocd/internal/features"
+	"github.com/argoproj/argo-cd/v3/pkg/apiclient/repository"
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/plancheck"
@@ -1354,3 +1356,62 @@ resource "argocd_repository" "oci_test" {
 		},
 	})
 }
+
+func TestAccArgoCDRepository_AdoptExisting(t *testing.T) {
+	repoURL := "https://github.com/argoproj/argocd-example-apps"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() {
+					si := testAccServerInterface(t)
+
+					_, err := si.RepositoryClient.CreateRepository(t.Context(), &repository.RepoCreateRequest{
+						Repo: &v1alpha1.Repository{
+							Repo: repoURL,
+							Name: "bootstrapped",
+						},
+					})
+					if err != nil {
+						t.Fatalf("failed to create repository %s: %s", repoURL, err.Error())
+					}
+				},
+				Config:      testAccArgoCDRepositoryAdoptExisting(repoURL, false),
+				ExpectError: regexp.MustCompile("repository .* already exists"),
+			},
+			{
+				Config: testAccArgoCDRepositoryAdoptExisting(repoURL, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"argocd_repository.adopt_existing",
+						"name",
+						"adopted",
+					),
+					resource.TestCheckResourceAttr(
+						"argocd_repository.adopt_existing",
+						"connection_state_status",
+						"Successful",
+					),
+				),
+			},
+			{
+				ResourceName:            "argocd_repository.adopt_existing",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"adopt_existing"},
+			},
+		},
+	})
+}
+
+func testAccArgoCDRepositoryAdoptExisting(repoURL string, adopt bool) string {
+	return fmt.Sprintf(`
+resource "argocd_repository" "adopt_existing" {
+  repo           = "%s"
+  name           = "adopted"
+  adopt_existing = %t
+}
+`, repoURL, adopt)
+}